@@ -42,4 +42,6 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&options.Upgrade, "upgrade", false, "Indicates that the install is being performed by an upgrade")
 	rootCmd.PersistentFlags().BoolVar(&options.Force, "force", false, "Indicates that the install should forcefully format the partition")
 	rootCmd.PersistentFlags().BoolVar(&options.Zero, "zero", false, "Indicates that the install should write zeros to the disk before installing")
+	rootCmd.PersistentFlags().StringArrayVar(&options.ImageCache, "image-cache", []string{}, "Image to store in the image cache partition (namespace=reference)")
+	rootCmd.PersistentFlags().StringVar(&options.ImageCacheSource, "image-cache-source", constants.ImageCacheSourcePath, "The path to the directory with the image cache archives")
 }
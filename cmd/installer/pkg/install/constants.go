@@ -35,4 +35,7 @@ const (
 	BootSize     = 300 * MiB
 	MetaSize     = 1 * MiB
 	StateSize    = 100 * MiB
+
+	// ImageCacheOverhead is added to the size of the images stored in the image cache.
+	ImageCacheOverhead = 100 * MiB
 )
@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/talos-systems/talos/pkg/images"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// imageCacheTarget builds the image cache target sized to fit the cached images.
+//
+// Images are expected to be stored as OCI archives in the image cache source directory
+// as <namespace>/<archive name>, either bundled into the installer image or mounted from the host.
+func (m *Manifest) imageCacheTarget(opts *Options) (*Target, error) {
+	if !opts.Force {
+		// the partition table is preserved, so there's no room for the new partition;
+		// images imported on previous boots are kept in the containerd content store
+		log.Printf("skipping image cache partition, as the disk is not wiped")
+
+		return nil, nil
+	}
+
+	for _, spec := range opts.ImageCache {
+		cached, err := images.ParseCachedImage(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		m.ImageCache = append(m.ImageCache, cached)
+	}
+
+	m.ImageCacheSource = opts.ImageCacheSource
+
+	size, err := archivesSize(m.ImageCacheSource, m.ImageCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return newImageCacheTarget(opts.Disk, size), nil
+}
+
+// newImageCacheTarget builds the image cache target which fits the images of the specified total size.
+func newImageCacheTarget(device string, imagesSize uint64) *Target {
+	target := ImageCacheTarget(device, nil)
+
+	// leave some room for the filesystem metadata, and round up to MiB
+	target.Size += imagesSize + imagesSize/10
+	target.Size = (target.Size + MiB - 1) / MiB * MiB
+
+	return target
+}
+
+// archivePath returns the path of the cached image archive relative to the image cache directory.
+func archivePath(cached images.CachedImage) string {
+	return filepath.Join(cached.Namespace, cached.ArchiveName())
+}
+
+// archivesSize returns the total size of the cached images archives.
+func archivesSize(source string, cachedImages []images.CachedImage) (uint64, error) {
+	var size uint64
+
+	for _, cached := range cachedImages {
+		st, err := os.Stat(filepath.Join(source, archivePath(cached)))
+		if err != nil {
+			return 0, fmt.Errorf("error looking up archive of image %q: %w", cached.Reference, err)
+		}
+
+		size += uint64(st.Size())
+	}
+
+	return size, nil
+}
+
+// ExportImageCache copies cached images OCI archives to the image cache partition.
+func (m *Manifest) ExportImageCache() error {
+	if len(m.ImageCache) == 0 {
+		return nil
+	}
+
+	var target *Target
+
+	for _, targets := range m.Targets {
+		for _, t := range targets {
+			if t.Label == constants.ImageCachePartitionLabel {
+				target = t
+			}
+		}
+	}
+
+	if target == nil {
+		return nil
+	}
+
+	return withTemporaryMounted(target.PartitionName, 0, target.FileSystemType, target.Label, func(mountPath string) error {
+		for _, cached := range m.ImageCache {
+			if err := copyArchive(m.ImageCacheSource, mountPath, cached); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func copyArchive(source, mountPath string, cached images.CachedImage) error {
+	dest := filepath.Join(mountPath, archivePath(cached))
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	log.Printf("copying %q to the image cache", cached.Reference)
+
+	from, err := os.Open(filepath.Join(source, archivePath(cached)))
+	if err != nil {
+		return err
+	}
+
+	defer from.Close() //nolint: errcheck
+
+	to, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+
+	defer to.Close() //nolint: errcheck
+
+	if _, err = io.Copy(to, from); err != nil {
+		return fmt.Errorf("error copying archive of image %q: %w", cached.Reference, err)
+	}
+
+	return to.Close()
+}
@@ -0,0 +1,130 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package install
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/images"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func TestNewImageCacheTarget(t *testing.T) {
+	target := newImageCacheTarget("/dev/sda", 0)
+
+	assert.Equal(t, "/dev/sda", target.Device)
+	assert.Equal(t, constants.ImageCachePartitionLabel, target.Label)
+	assert.EqualValues(t, ImageCacheOverhead, target.Size)
+
+	// 10% on top of the images size, rounded up to MiB
+	assert.EqualValues(t, ImageCacheOverhead+1100*MiB, newImageCacheTarget("/dev/sda", 1000*MiB).Size)
+	assert.EqualValues(t, ImageCacheOverhead+MiB, newImageCacheTarget("/dev/sda", 1).Size)
+}
+
+func TestImageCacheTargetPreserve(t *testing.T) {
+	m := &Manifest{}
+
+	target, err := m.imageCacheTarget(&Options{
+		Disk:       "/dev/sda",
+		ImageCache: []string{"k8s.io=docker.io/library/busybox:1.30.1"},
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, target)
+	assert.Empty(t, m.ImageCache)
+}
+
+func TestImageCacheTarget(t *testing.T) {
+	source, err := ioutil.TempDir("", "imagecache")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(source) //nolint: errcheck
+
+	require.NoError(t, os.Mkdir(filepath.Join(source, "k8s.io"), 0o755))
+
+	archive := filepath.Join(source, "k8s.io", "docker.io_library_busybox_1.30.1.tar")
+	require.NoError(t, ioutil.WriteFile(archive, nil, 0o644))
+	require.NoError(t, os.Truncate(archive, 1000*MiB))
+
+	m := &Manifest{}
+
+	target, err := m.imageCacheTarget(&Options{
+		Disk:             "/dev/sda",
+		Force:            true,
+		ImageCache:       []string{"k8s.io=docker.io/library/busybox:1.30.1"},
+		ImageCacheSource: source,
+	})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, ImageCacheOverhead+1100*MiB, target.Size)
+	assert.Equal(t, []images.CachedImage{{Namespace: "k8s.io", Reference: "docker.io/library/busybox:1.30.1"}}, m.ImageCache)
+	assert.Equal(t, source, m.ImageCacheSource)
+
+	// archive of the image is not in the image cache source
+	_, err = (&Manifest{}).imageCacheTarget(&Options{
+		Disk:             "/dev/sda",
+		Force:            true,
+		ImageCache:       []string{"k8s.io=docker.io/library/busybox:1.31.0"},
+		ImageCacheSource: source,
+	})
+	assert.True(t, errors.Is(err, os.ErrNotExist), "unexpected error %v", err)
+}
+
+func TestImageCacheTargetInvalid(t *testing.T) {
+	_, err := (&Manifest{}).imageCacheTarget(&Options{
+		Disk:       "/dev/sda",
+		Force:      true,
+		ImageCache: []string{"docker.io/library/busybox:1.30.1"},
+	})
+	assert.EqualError(t, err, `invalid cached image "docker.io/library/busybox:1.30.1", expected namespace=reference`)
+}
+
+func TestExportImageCacheNoTarget(t *testing.T) {
+	m := &Manifest{
+		Targets: map[string][]*Target{
+			"/dev/sda": {EphemeralTarget("/dev/sda", nil)},
+		},
+		ImageCache: []images.CachedImage{
+			{Namespace: "k8s.io", Reference: "docker.io/library/busybox:1.30.1"},
+		},
+	}
+
+	// no image cache partition, nothing to export to
+	assert.NoError(t, m.ExportImageCache())
+}
+
+func TestCopyArchive(t *testing.T) {
+	source, err := ioutil.TempDir("", "imagecache")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(source) //nolint: errcheck
+
+	mountPath, err := ioutil.TempDir("", "imagecache")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(mountPath) //nolint: errcheck
+
+	require.NoError(t, os.Mkdir(filepath.Join(source, "system"), 0o755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "system", "gcr.io_etcd-development_etcd_v3.4.14.tar"), []byte("archive"), 0o644))
+
+	cached := images.CachedImage{Namespace: "system", Reference: "gcr.io/etcd-development/etcd:v3.4.14"}
+
+	require.NoError(t, copyArchive(source, mountPath, cached))
+
+	// archive is stored in the namespace directory
+	contents, err := ioutil.ReadFile(filepath.Join(mountPath, "system", "gcr.io_etcd-development_etcd_v3.4.14.tar"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("archive"), contents)
+
+	assert.Error(t, copyArchive(source, mountPath, images.CachedImage{Namespace: "k8s.io", Reference: "docker.io/library/busybox:1.30.1"}))
+}
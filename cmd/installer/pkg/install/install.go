@@ -26,15 +26,17 @@ import (
 
 // Options represents the set of options available for an install.
 type Options struct {
-	ConfigSource    string
-	Disk            string
-	Platform        string
-	Board           string
-	ExtraKernelArgs []string
-	Bootloader      bool
-	Upgrade         bool
-	Force           bool
-	Zero            bool
+	ConfigSource     string
+	Disk             string
+	Platform         string
+	Board            string
+	ExtraKernelArgs  []string
+	Bootloader       bool
+	Upgrade          bool
+	Force            bool
+	Zero             bool
+	ImageCache       []string
+	ImageCacheSource string
 }
 
 // Install installs Talos.
@@ -206,6 +208,12 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		}
 	}
 
+	// Populate the image cache.
+
+	if err = i.manifest.ExportImageCache(); err != nil {
+		return err
+	}
+
 	// Install the bootloader.
 
 	if !i.options.Bootloader {
@@ -270,6 +278,13 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 			return fmt.Errorf("failed to set upgrade tag: %q", i.Current)
 		}
 
+		// new installation is booted on trial, and reverted if it doesn't become healthy
+		if i.Current != "" {
+			if ok := meta.ADV.SetTag(adv.UpgradeTrial, i.Next); !ok {
+				return fmt.Errorf("failed to set upgrade trial tag: %q", i.Next)
+			}
+		}
+
 		if err = meta.Write(); err != nil {
 			return err
 		}
@@ -22,6 +22,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/pkg/images"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -31,6 +32,11 @@ type Manifest struct {
 	PartitionOptions *runtime.PartitionOptions
 	Devices          map[string]Device
 	Targets          map[string][]*Target
+
+	// ImageCache is the list of images to copy to the image cache partition.
+	ImageCache []images.CachedImage
+	// ImageCacheSource is the directory holding the cached images archives.
+	ImageCacheSource string
 }
 
 // Device represents device options.
@@ -140,6 +146,14 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		},
 	})
 
+	var imageCacheTarget *Target
+
+	if len(opts.ImageCache) > 0 {
+		if imageCacheTarget, err = manifest.imageCacheTarget(opts); err != nil {
+			return nil, fmt.Errorf("failed to prepare image cache partition: %w", err)
+		}
+	}
+
 	ephemeralTarget := EphemeralTarget(opts.Disk, nil)
 
 	if opts.Force {
@@ -150,7 +164,7 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		stateTarget.Size = 0 // expand previous partition to cover whatever space is available
 	}
 
-	for _, target := range []*Target{efiTarget, biosTarget, bootTarget, metaTarget, stateTarget, ephemeralTarget} {
+	for _, target := range []*Target{efiTarget, biosTarget, bootTarget, metaTarget, stateTarget, imageCacheTarget, ephemeralTarget} {
 		if target == nil {
 			continue
 		}
@@ -141,6 +141,20 @@ func StateTarget(device string, extra *Target) *Target {
 	return target.enhance(extra)
 }
 
+// ImageCacheTarget builds the default image cache target.
+func ImageCacheTarget(device string, extra *Target) *Target {
+	target := &Target{
+		Device:         device,
+		Label:          constants.ImageCachePartitionLabel,
+		PartitionType:  LinuxFilesystemData,
+		FileSystemType: FilesystemTypeXFS,
+		Size:           ImageCacheOverhead,
+		Force:          true,
+	}
+
+	return target.enhance(extra)
+}
+
 // EphemeralTarget builds the default ephemeral target.
 func EphemeralTarget(device string, extra *Target) *Target {
 	target := &Target{
@@ -37,6 +37,7 @@ func Execute() error {
 	rootCmd.PersistentFlags().StringVar(&talos.Talosconfig, "talosconfig", defaultTalosConfig, "The path to the Talos configuration file")
 	rootCmd.PersistentFlags().StringVar(&talos.Cmdcontext, "context", "", "Context to be used in command")
 	rootCmd.PersistentFlags().StringSliceVarP(&talos.Nodes, "nodes", "n", []string{}, "target the specified nodes")
+	rootCmd.PersistentFlags().StringVar(&talos.NodesSelector, "nodes-selector", "", "target the nodes matching the selector resolved by the endpoint (\"all\", \"role=controlplane\" or \"role=worker\")")
 	rootCmd.PersistentFlags().StringSliceVarP(&talos.Endpoints, "endpoints", "e", []string{}, "override default endpoints in Talos configuration")
 
 	cmd, err := rootCmd.ExecuteC()
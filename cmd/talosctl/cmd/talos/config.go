@@ -5,6 +5,7 @@
 package talos
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -12,11 +13,17 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/talos-systems/crypto/x509"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
 var (
@@ -263,13 +270,170 @@ var configMergeCmd = &cobra.Command{
 	},
 }
 
+// configInfoCmd represents the config info command.
+var configInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show client certificate information for contexts defined in Talos config",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := clientconfig.Open(Talosconfig)
+		if err != nil {
+			return fmt.Errorf("error reading config: %w", err)
+		}
+
+		keys := make([]string, 0, len(c.Contexts))
+		for key := range c.Contexts {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "CURRENT\tNAME\tSUBJECT\tROLES\tEXPIRES")
+
+		for _, name := range keys {
+			var current string
+
+			if name == c.Context {
+				current = "*"
+			}
+
+			crt, err := c.Contexts[name].Certificate()
+			if err != nil {
+				fmt.Fprintf(w, "%s\t%s\t<error: %s>\t\t\n", current, name, err)
+
+				continue
+			}
+
+			roles := strings.Join(crt.Subject.Organization, ",")
+			if roles == "" {
+				roles = "<none>"
+			}
+
+			expires := fmt.Sprintf("%s (%s)", crt.NotAfter.Format(time.RFC3339), expiresIn(crt.NotAfter))
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, name, crt.Subject, roles, expires)
+		}
+
+		return w.Flush()
+	},
+}
+
+var configRenewCmdFlags struct {
+	ttl time.Duration
+}
+
+// configRenewCmd represents the config renew command.
+var configRenewCmd = &cobra.Command{
+	Use:   "renew",
+	Short: "Renew the client certificate of the current context",
+	Long: `The node issues a new client certificate signed by the Talos API CA for a newly generated key.
+The new certificate has the same subject and roles as the current client certificate.
+Current client certificate should not be expired yet, as it is used to authenticate the request.
+The request is sent directly to the endpoint, which should be a control plane node.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClientNoNodes(renewClientCertificate)
+	},
+}
+
+func renewClientCertificate(ctx context.Context, c *client.Client) error {
+	cfg, err := openConfigAndContext(Cmdcontext)
+	if err != nil {
+		return err
+	}
+
+	contextName := Cmdcontext
+	if contextName == "" {
+		contextName = cfg.Context
+	}
+
+	configContext := cfg.Contexts[contextName]
+
+	crt, err := configContext.Certificate()
+	if err != nil {
+		return err
+	}
+
+	if time.Now().After(crt.NotAfter) {
+		return fmt.Errorf("client certificate expired at %s, it can't be renewed via the API", crt.NotAfter)
+	}
+
+	csr, identity, err := x509.NewEd25519CSRAndIdentity()
+	if err != nil {
+		return fmt.Errorf("error generating certificate signing request: %w", err)
+	}
+
+	resp, err := c.GenerateClientCertificate(ctx, &machineapi.GenerateClientCertificateRequest{
+		Csr: csr.X509CertificateRequestPEM,
+		Ttl: durationpb.New(configRenewCmdFlags.ttl),
+	})
+	if err != nil {
+		return fmt.Errorf("error renewing client certificate: %w", err)
+	}
+
+	if len(resp.GetMessages()) != 1 {
+		return fmt.Errorf("unexpected number of responses: %d", len(resp.GetMessages()))
+	}
+
+	msg := resp.GetMessages()[0]
+
+	configContext.CA = base64.StdEncoding.EncodeToString(msg.GetCa())
+	configContext.Crt = base64.StdEncoding.EncodeToString(msg.GetCrt())
+	configContext.Key = base64.StdEncoding.EncodeToString(identity.Key)
+
+	if err = cfg.Save(Talosconfig); err != nil {
+		return fmt.Errorf("error writing config: %w", err)
+	}
+
+	renewed, err := configContext.Certificate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("client certificate for context %q renewed, expires at %s\n", contextName, renewed.NotAfter.Format(time.RFC3339))
+
+	return nil
+}
+
+func expiresIn(notAfter time.Time) string {
+	left := time.Until(notAfter)
+	if left < 0 {
+		return "expired"
+	}
+
+	if left < 48*time.Hour {
+		return fmt.Sprintf("%s left", left.Round(time.Minute))
+	}
+
+	return fmt.Sprintf("%d days left", int(left.Hours()/24))
+}
+
+// warnClientCertificateExpiry prints a warning if the client certificate is about to expire.
+func warnClientCertificateExpiry(configContext *clientconfig.Context) {
+	if configContext == nil || configContext.Crt == "" {
+		return
+	}
+
+	crt, err := configContext.Certificate()
+	if err != nil {
+		return
+	}
+
+	if time.Until(crt.NotAfter) < constants.TalosAdminCertExpiryWarning {
+		fmt.Fprintf(os.Stderr, "WARNING: client certificate expires at %s (%s), run 'talosctl config renew' to renew it\n", crt.NotAfter.Format(time.RFC3339), expiresIn(crt.NotAfter))
+	}
+}
+
 func init() {
-	configCmd.AddCommand(configContextCmd, configEndpointCmd, configNodeCmd, configAddCmd, configGenerateCmd, configMergeCmd, configGetContexts)
+	configCmd.AddCommand(configContextCmd, configEndpointCmd, configNodeCmd, configAddCmd, configGenerateCmd, configMergeCmd, configGetContexts, configInfoCmd, configRenewCmd)
 	configAddCmd.Flags().StringVar(&ca, "ca", "", "the path to the CA certificate")
 	configAddCmd.Flags().StringVar(&crt, "crt", "", "the path to the certificate")
 	configAddCmd.Flags().StringVar(&key, "key", "", "the path to the key")
 	cli.Should(configAddCmd.MarkFlagRequired("ca"))
 	cli.Should(configAddCmd.MarkFlagRequired("crt"))
 	cli.Should(configAddCmd.MarkFlagRequired("key"))
+	configRenewCmd.Flags().DurationVar(&configRenewCmdFlags.ttl, "ttl", constants.TalosAdminCertDefaultLifetime, "lifetime of the renewed certificate")
 	addCommand(configCmd)
 }
@@ -15,9 +15,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
+var cpCmdFlags struct {
+	toNode bool
+}
+
 // cpCmd represents the cp command.
 var cpCmd = &cobra.Command{
 	Use:     "copy <src-path> -|<local-path>",
@@ -30,7 +35,13 @@ If '-' is given for <local-path>, archive is written to stdout.
 Otherwise archive is extracted to <local-path> which should be an empty directory or
 talosctl creates a directory if <local-path> doesn't exist. Command doesn't preserve
 ownership and access mode for the files in extract mode, while  streamed .tar archive
-captures ownership and permission bits.`,
+captures ownership and permission bits.
+
+With '--to-node' the direction is reversed: talosctl copy --to-node <local-path> <dest-path>
+uploads <local-path> to the node and extracts it under <dest-path> preserving access modes.
+If <local-path> is a directory, its contents are placed into <dest-path>, a single file
+is placed into <dest-path> under its own name. Existing files are replaced.
+<dest-path> should be located under writable paths: /var or /var/system/overlays.`,
 	Args: cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
@@ -38,6 +49,10 @@ captures ownership and permission bits.`,
 				return err
 			}
 
+			if cpCmdFlags.toNode {
+				return uploadToNode(ctx, c, args[0], args[1])
+			}
+
 			r, errCh, err := c.Copy(ctx, args[0])
 			if err != nil {
 				return fmt.Errorf("error copying: %w", err)
@@ -83,6 +98,33 @@ captures ownership and permission bits.`,
 	},
 }
 
+func uploadToNode(ctx context.Context, c *client.Client, localPath, destPath string) error {
+	if _, err := os.Stat(localPath); err != nil {
+		return fmt.Errorf("failed to stat local path: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(archiver.TarGz(ctx, localPath, pw)) //nolint: errcheck
+	}()
+
+	resp, err := c.Upload(ctx, destPath, pr)
+
+	pr.Close() //nolint: errcheck
+
+	if err != nil {
+		return fmt.Errorf("error uploading: %w", err)
+	}
+
+	for _, msg := range resp.Messages {
+		fmt.Fprintf(os.Stderr, "uploaded %s to %s\n", localPath, msg.Path)
+	}
+
+	return nil
+}
+
 func init() {
+	cpCmd.Flags().BoolVar(&cpCmdFlags.toNode, "to-node", false, "upload <local-path> to the node and extract it under <dest-path>")
 	addCommand(cpCmd)
 }
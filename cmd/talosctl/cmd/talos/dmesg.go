@@ -6,18 +6,41 @@ package talos
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
-var dmesgTail bool
+var dmesgCmdFlags struct {
+	tail       bool
+	level      string
+	facilities []string
+	since      time.Duration
+	output     string
+}
+
+// dmesgJSONMessage is a kernel log message in the JSON output.
+type dmesgJSONMessage struct {
+	Node      string  `json:"node"`
+	Facility  string  `json:"facility"`
+	Priority  string  `json:"priority"`
+	Sequence  int64   `json:"sequence"`
+	Clock     float64 `json:"clock"`
+	Timestamp string  `json:"timestamp"`
+	Message   string  `json:"message"`
+}
 
 // dmesgCmd represents the dmesg command.
 var dmesgCmd = &cobra.Command{
@@ -26,13 +49,32 @@ var dmesgCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		switch dmesgCmdFlags.output {
+		case "text", "json":
+		default:
+			return fmt.Errorf("unsupported output mode %q", dmesgCmdFlags.output)
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			stream, err := c.Dmesg(ctx, follow, dmesgTail)
+			req := &machine.DmesgRequest{
+				Follow:     follow,
+				Tail:       dmesgCmdFlags.tail,
+				Level:      dmesgCmdFlags.level,
+				Facilities: dmesgCmdFlags.facilities,
+			}
+
+			if dmesgCmdFlags.since != 0 {
+				req.Since = timestamppb.New(time.Now().Add(-dmesgCmdFlags.since))
+			}
+
+			stream, err := c.MachineClient.Dmesg(ctx, req)
 			if err != nil {
 				return fmt.Errorf("error getting dmesg: %w", err)
 			}
 
 			defaultNode := client.RemotePeer(stream.Context())
+			colorizer := helpers.NewNodeColorizer()
+			encoder := json.NewEncoder(os.Stdout)
 
 			for {
 				resp, err := stream.Recv()
@@ -49,13 +91,37 @@ var dmesgCmd = &cobra.Command{
 					node = resp.Metadata.Hostname
 
 					if resp.Metadata.Error != "" {
-						fmt.Fprintf(os.Stderr, "%s: %s\n", node, resp.Metadata.Error)
+						// the node failed, but streaming continues for the rest of the nodes
+						fmt.Fprintf(os.Stderr, "%s: ERROR: %s\n", colorizer.Node(node), resp.Metadata.Error)
 					}
 				}
 
-				if resp.Bytes != nil {
-					fmt.Printf("%s: %s", node, resp.Bytes)
+				if resp.Bytes == nil {
+					continue
 				}
+
+				if dmesgCmdFlags.output == "json" {
+					msg := dmesgJSONMessage{
+						Node:     node,
+						Facility: resp.Facility,
+						Priority: resp.Priority,
+						Sequence: resp.Sequence,
+						Clock:    resp.Clock.AsDuration().Seconds(),
+						Message:  strings.TrimRight(resp.Message, "\n"),
+					}
+
+					if resp.Timestamp != nil {
+						msg.Timestamp = resp.Timestamp.AsTime().Format(time.RFC3339Nano)
+					}
+
+					if err = encoder.Encode(&msg); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				fmt.Printf("%s: %s", colorizer.Node(node), resp.Bytes)
 			}
 
 			return nil
@@ -66,5 +132,9 @@ var dmesgCmd = &cobra.Command{
 func init() {
 	addCommand(dmesgCmd)
 	dmesgCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the kernel log should be streamed")
-	dmesgCmd.Flags().BoolVarP(&dmesgTail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().BoolVarP(&dmesgCmdFlags.tail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.level, "level", "", "show only messages with the specified or higher priority (emerg, alert, crit, err, warn, notice, info, debug)")
+	dmesgCmd.Flags().StringSliceVar(&dmesgCmdFlags.facilities, "facility", nil, "show only messages from the specified facilities (e.g. kern, daemon)")
+	dmesgCmd.Flags().DurationVar(&dmesgCmdFlags.since, "since", 0, "show only messages logged within the specified duration (e.g. 10m)")
+	dmesgCmd.Flags().StringVarP(&dmesgCmdFlags.output, "output", "o", "text", "output mode (text, json)")
 }
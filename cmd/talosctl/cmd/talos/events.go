@@ -8,11 +8,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
@@ -21,6 +23,12 @@ var eventsCmdFlags struct {
 	tailEvents   int32
 	tailDuration time.Duration
 	tailID       string
+	boot         int32
+	bootID       string
+	from         string
+	to           string
+	types        []string
+	names        []string
 }
 
 // eventsCmd represents the events command.
@@ -31,6 +39,7 @@ var eventsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			colorizer := helpers.NewNodeColorizer()
 			fmt.Fprintln(w, "NODE\tID\tEVENT\tSOURCE\tMESSAGE")
 
 			opts := []client.EventsOptionFunc{}
@@ -47,6 +56,44 @@ var eventsCmd = &cobra.Command{
 				opts = append(opts, client.WithTailID(eventsCmdFlags.tailID))
 			}
 
+			if eventsCmdFlags.boot != 0 {
+				opts = append(opts, client.WithBoot(eventsCmdFlags.boot))
+			}
+
+			if eventsCmdFlags.bootID != "" {
+				opts = append(opts, client.WithBootID(eventsCmdFlags.bootID))
+			}
+
+			if len(eventsCmdFlags.types) > 0 {
+				opts = append(opts, client.WithEventTypes(eventsCmdFlags.types...))
+			}
+
+			if len(eventsCmdFlags.names) > 0 {
+				opts = append(opts, client.WithEventNames(eventsCmdFlags.names...))
+			}
+
+			if eventsCmdFlags.from != "" || eventsCmdFlags.to != "" {
+				var from, to time.Time
+
+				if eventsCmdFlags.from != "" {
+					var err error
+
+					if from, err = time.Parse(time.RFC3339, eventsCmdFlags.from); err != nil {
+						return fmt.Errorf("error parsing --from: %w", err)
+					}
+				}
+
+				if eventsCmdFlags.to != "" {
+					var err error
+
+					if to, err = time.Parse(time.RFC3339, eventsCmdFlags.to); err != nil {
+						return fmt.Errorf("error parsing --to: %w", err)
+					}
+				}
+
+				opts = append(opts, client.WithTimeRange(from, to))
+			}
+
 			return c.EventsWatch(ctx, func(ch <-chan client.Event) {
 				for {
 					var (
@@ -63,6 +110,13 @@ var eventsCmd = &cobra.Command{
 						return
 					}
 
+					if event.Error != nil {
+						// the node failed, it is reconnected if it's unavailable
+						fmt.Fprintf(os.Stderr, "%s: ERROR: %s\n", colorizer.Node(event.Node), event.Error)
+
+						continue
+					}
+
 					format := "%s\t%s\t%s\t%s\t%s\n"
 
 					var args []interface{}
@@ -81,6 +135,21 @@ var eventsCmd = &cobra.Command{
 						args = []interface{}{msg.GetTask(), msg.GetAction().String()}
 					case *machine.ServiceStateEvent:
 						args = []interface{}{msg.GetService(), fmt.Sprintf("%s: %s", msg.GetAction(), msg.GetMessage())}
+					case *machine.UpgradeTrialEvent:
+						args = []interface{}{msg.GetLabel(), fmt.Sprintf("%s: %s", msg.GetAction(), msg.GetMessage())}
+					case *machine.ConfigEvent:
+						mode := "reboot"
+						if msg.GetNoReboot() {
+							mode = "no reboot"
+						}
+
+						args = []interface{}{"config", fmt.Sprintf("applied (%s): %s", mode, msg.GetHash())}
+					case *machine.AddressEvent:
+						args = []interface{}{msg.GetLink(), fmt.Sprintf("%s: %s", msg.GetAction(), msg.GetAddress())}
+					case *machine.TimeSyncEvent:
+						args = []interface{}{"time", fmt.Sprintf("synced: %v", msg.GetSynced())}
+					case *machine.EtcdMemberEvent:
+						args = []interface{}{msg.GetName(), fmt.Sprintf("%s: %x %s", msg.GetAction(), msg.GetMemberId(), strings.Join(msg.GetPeerUrls(), ","))}
 					default:
 						// We haven't implemented the handling of this event yet.
 						continue
@@ -102,4 +171,10 @@ func init() {
 	eventsCmd.Flags().Int32Var(&eventsCmdFlags.tailEvents, "tail", 0, "show specified number of past events (use -1 to show full history, default is to show no history)")
 	eventsCmd.Flags().DurationVar(&eventsCmdFlags.tailDuration, "duration", 0, "show events for the past duration interval (one second resolution, default is to show no history)")
 	eventsCmd.Flags().StringVar(&eventsCmdFlags.tailID, "since", "", "show events after the specified event ID (default is to show no history)")
+	eventsCmd.Flags().Int32Var(&eventsCmdFlags.boot, "boot", 0, "show persisted events of the previous boot (-1 is the previous boot, -2 is the one before it, etc.)")
+	eventsCmd.Flags().StringVar(&eventsCmdFlags.bootID, "boot-id", "", "show persisted events of the boot with the specified ID")
+	eventsCmd.Flags().StringVar(&eventsCmdFlags.from, "from", "", "show events starting at the specified time (RFC3339)")
+	eventsCmd.Flags().StringVar(&eventsCmdFlags.to, "to", "", "show events up to the specified time (RFC3339)")
+	eventsCmd.Flags().StringSliceVar(&eventsCmdFlags.types, "type", nil, "show only events of the specified types (e.g. ServiceStateEvent)")
+	eventsCmd.Flags().StringSliceVar(&eventsCmdFlags.names, "name", nil, "show only events with the specified sequence, phase, task, service, link or etcd member name")
 }
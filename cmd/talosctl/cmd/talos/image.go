@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	criconstants "github.com/containerd/cri/pkg/constants"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// imageCmd represents the image command.
+var imageCmd = &cobra.Command{
+	Use:   "image",
+	Short: "Manage container images on the nodes",
+	Long:  ``,
+}
+
+func imageNamespace() string {
+	if kubernetes {
+		return criconstants.K8sContainerdNamespace
+	}
+
+	return constants.SystemContainerdNamespace
+}
+
+// imageListCmd represents the image ls command.
+var imageListCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List container images",
+	Long:    ``,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.ImageList(ctx, imageNamespace())
+			if err != nil {
+				return fmt.Errorf("error listing images: %w", err)
+			}
+
+			defaultNode := client.RemotePeer(stream.Context())
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAMESPACE\tIMAGE\tDIGEST\tSIZE\tCREATED")
+
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					if err == io.EOF || status.Code(err) == codes.Canceled {
+						break
+					}
+
+					return fmt.Errorf("error reading from stream: %w", err)
+				}
+
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+
+					if msg.Metadata.Error != "" {
+						fmt.Fprintf(os.Stderr, "%s: %s\n", node, msg.Metadata.Error)
+
+						continue
+					}
+				}
+
+				created := ""
+				if msg.CreatedAt != nil {
+					created = humanize.Time(msg.CreatedAt.AsTime())
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", node, msg.Namespace, msg.Name, msg.Digest, humanize.Bytes(uint64(msg.Size)), created)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// imagePullCmd represents the image pull command.
+var imagePullCmd = &cobra.Command{
+	Use:   "pull <image>",
+	Short: "Pull container image",
+	Long: `Pull the container image into the containerd namespace on the nodes.
+
+Images pulled into the k8s.io namespace ('--kubernetes') are available to the kubelet,
+so they can be used to pre-pull images before upgrades.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.ImagePull(ctx, imageNamespace(), args[0])
+			if err != nil {
+				return fmt.Errorf("error pulling image: %w", err)
+			}
+
+			defaultNode := client.RemotePeer(stream.Context())
+			colorizer := helpers.NewNodeColorizer()
+
+			var failed bool
+
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					if err == io.EOF || status.Code(err) == codes.Canceled {
+						break
+					}
+
+					return fmt.Errorf("error reading from stream: %w", err)
+				}
+
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+
+					if msg.Metadata.Error != "" {
+						fmt.Fprintf(os.Stderr, "%s: ERROR: %s\n", colorizer.Node(node), msg.Metadata.Error)
+
+						failed = true
+
+						continue
+					}
+				}
+
+				if msg.ImageRef != "" {
+					fmt.Printf("%s: pulled %s\n", colorizer.Node(node), msg.ImageRef)
+
+					continue
+				}
+
+				var offset, total int64
+
+				for _, progress := range msg.Progress {
+					offset += progress.Offset
+					total += progress.Total
+				}
+
+				fmt.Fprintf(os.Stderr, "%s: downloading %d layer(s): %s / %s\n", colorizer.Node(node), len(msg.Progress),
+					humanize.Bytes(uint64(offset)), humanize.Bytes(uint64(total)))
+			}
+
+			if failed {
+				return fmt.Errorf("failed to pull image on some nodes")
+			}
+
+			return nil
+		})
+	},
+}
+
+// imageRemoveCmd represents the image rm command.
+var imageRemoveCmd = &cobra.Command{
+	Use:     "rm <image>",
+	Aliases: []string{"remove"},
+	Short:   "Remove container image",
+	Long:    ``,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ImageRemove(ctx, imageNamespace(), args[0], grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error removing image: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Printf("%s: removed %s\n", node, args[0])
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	imageCmd.PersistentFlags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
+
+	imageCmd.AddCommand(imageListCmd, imagePullCmd, imageRemoveCmd)
+	addCommand(imageCmd)
+}
@@ -11,12 +11,15 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	criconstants "github.com/containerd/cri/pkg/constants"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
@@ -29,12 +32,22 @@ var (
 	tailLines int32
 )
 
+var logsCmdFlags struct {
+	since     time.Duration
+	until     time.Duration
+	grep      string
+	grepRegex bool
+}
+
 // logsCmd represents the logs command.
 var logsCmd = &cobra.Command{
-	Use:   "logs <service name>",
+	Use:   "logs <service name> [<service name>...]",
 	Short: "Retrieve logs for a service",
-	Long:  ``,
-	Args:  cobra.ExactArgs(1),
+	Long: `Retrieve logs for a service.
+
+When several services are specified, logs are interleaved by time and each line is prefixed with the service name.
+Filtering by time and contents (--since, --until, --grep) and multiple services are supported only for the system services.`,
+	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			var namespace string
@@ -49,18 +62,46 @@ var logsCmd = &cobra.Command{
 				driver = common.ContainerDriver_CRI
 			}
 
-			stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
+			req := &machine.LogsRequest{
+				Namespace: namespace,
+				Driver:    driver,
+				Id:        args[0],
+				Ids:       args[1:],
+				Follow:    follow,
+				TailLines: tailLines,
+				Grep:      logsCmdFlags.grep,
+				GrepRegex: logsCmdFlags.grepRegex,
+			}
+
+			now := time.Now()
+
+			if logsCmdFlags.since != 0 {
+				req.Since = timestamppb.New(now.Add(-logsCmdFlags.since))
+			}
+
+			if logsCmdFlags.until != 0 {
+				req.Until = timestamppb.New(now.Add(-logsCmdFlags.until))
+			}
+
+			stream, err := c.MachineClient.Logs(ctx, req)
 			if err != nil {
 				return fmt.Errorf("error fetching logs: %s", err)
 			}
 
 			defaultNode := client.RemotePeer(stream.Context())
+			colorizer := helpers.NewNodeColorizer()
 
 			respCh, errCh := newLineSlicer(stream)
 
 			for data := range respCh {
+				node := defaultNode
+				if data.Metadata != nil && data.Metadata.Hostname != "" {
+					node = data.Metadata.Hostname
+				}
+
 				if data.Metadata != nil && data.Metadata.Error != "" {
-					_, err = fmt.Fprintf(os.Stderr, "ERROR: %s\n", data.Metadata.Error)
+					// the node failed, but streaming continues for the rest of the nodes
+					_, err = fmt.Fprintf(os.Stderr, "%s: ERROR: %s\n", colorizer.Node(node), data.Metadata.Error)
 					if err != nil {
 						return err
 					}
@@ -68,12 +109,7 @@ var logsCmd = &cobra.Command{
 					continue
 				}
 
-				node := defaultNode
-				if data.Metadata != nil && data.Metadata.Hostname != "" {
-					node = data.Metadata.Hostname
-				}
-
-				_, err = fmt.Printf("%s: %s\n", node, data.Bytes)
+				_, err = fmt.Printf("%s: %s\n", colorizer.Node(node), data.Bytes)
 				if err != nil {
 					return err
 				}
@@ -188,5 +224,9 @@ func init() {
 	logsCmd.Flags().BoolVarP(&useCRI, "use-cri", "c", false, "use the CRI driver")
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32VarP(&tailLines, "tail", "", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().DurationVar(&logsCmdFlags.since, "since", 0, "show only lines written within the specified duration (e.g. 10m)")
+	logsCmd.Flags().DurationVar(&logsCmdFlags.until, "until", 0, "show only lines written before the specified duration ago (e.g. 5m)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.grep, "grep", "", "show only lines containing the specified string")
+	logsCmd.Flags().BoolVar(&logsCmdFlags.grepRegex, "grep-regex", false, "treat --grep as a regular expression")
 	addCommand(logsCmd)
 }
@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/net/bpf"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/internal/pkg/pcap/filter"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+var pcapCmdFlags struct {
+	iface       string
+	promiscuous bool
+	snapLen     uint32
+	bpfFilter   string
+	bpfRaw      string
+	duration    time.Duration
+	output      string
+}
+
+// pcapCmd represents the pcap command.
+var pcapCmd = &cobra.Command{
+	Use:   "pcap",
+	Short: "Capture the network packets on the node",
+	Long: `Capture the network packets on the node and write them in pcap-ng format.
+
+The output can be written to a file or to stdout ('-o -') and opened with Wireshark or tcpdump:
+
+    talosctl pcap --interface eth0 --bpf-filter 'udp port 53' -o out.pcap
+    talosctl pcap --interface eth0 -o - | tcpdump -r -
+
+Filter expressions support a subset of pcap-filter syntax: protocols (ip, ip6, arp, tcp, udp, icmp, icmp6),
+'[src|dst] host <address>', '[src|dst] net <cidr>' and '[tcp|udp] [src|dst] port <number>' combined with
+'and', 'or', 'not' and parentheses. Expressions are compiled on the node for the link type of the interface.
+Any other filter can be compiled locally with 'tcpdump -ddd <expression>' and passed via '--bpf-raw',
+in that case the program should match the link type of the interface (e.g. 'tcpdump -y RAW' for raw IP interfaces).
+
+Capture is stopped by the node after 30 minutes at most.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "pcap"); err != nil {
+				return err
+			}
+
+			program, err := pcapFilter()
+			if err != nil {
+				return err
+			}
+
+			req := &machine.PacketCaptureRequest{
+				Interface:   pcapCmdFlags.iface,
+				Promiscuous: pcapCmdFlags.promiscuous,
+				SnapLen:     pcapCmdFlags.snapLen,
+				Duration:    durationpb.New(pcapCmdFlags.duration),
+				Filter:      pcapCmdFlags.bpfFilter,
+			}
+
+			for _, ins := range program {
+				req.BpfFilter = append(req.BpfFilter, &machine.BPFInstruction{
+					Op: uint32(ins.Op),
+					Jt: uint32(ins.Jt),
+					Jf: uint32(ins.Jf),
+					K:  ins.K,
+				})
+			}
+
+			var out io.Writer = os.Stdout
+
+			if pcapCmdFlags.output != "-" {
+				f, createErr := os.Create(pcapCmdFlags.output)
+				if createErr != nil {
+					return fmt.Errorf("error creating output file: %w", createErr)
+				}
+
+				//nolint: errcheck
+				defer f.Close()
+
+				out = f
+			}
+
+			r, errCh, err := c.PacketCapture(ctx, req)
+			if err != nil {
+				return fmt.Errorf("error capturing packets: %w", err)
+			}
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for streamErr := range errCh {
+					fmt.Fprintln(os.Stderr, streamErr.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			if _, err = io.Copy(out, r); err != nil {
+				return fmt.Errorf("error capturing packets: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func pcapFilter() ([]bpf.RawInstruction, error) {
+	switch {
+	case pcapCmdFlags.bpfFilter != "" && pcapCmdFlags.bpfRaw != "":
+		return nil, fmt.Errorf("`--bpf-filter` and `--bpf-raw` can't be used together")
+	case pcapCmdFlags.bpfRaw != "":
+		program, err := filter.ParseRaw(pcapCmdFlags.bpfRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing BPF program: %w", err)
+		}
+
+		return program, nil
+	default:
+		return nil, nil
+	}
+}
+
+func init() {
+	addCommand(pcapCmd)
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.iface, "interface", "i", "eth0", "interface name to capture packets on")
+	pcapCmd.Flags().BoolVar(&pcapCmdFlags.promiscuous, "promiscuous", false, "put interface into promiscuous mode")
+	pcapCmd.Flags().Uint32VarP(&pcapCmdFlags.snapLen, "snaplen", "s", constants.DefaultPacketCaptureSnapLen, "maximum number of bytes to capture per packet")
+	pcapCmd.Flags().StringVar(&pcapCmdFlags.bpfFilter, "bpf-filter", "", "filter expression, e.g. 'tcp port 443 and host 10.5.0.2'")
+	pcapCmd.Flags().StringVar(&pcapCmdFlags.bpfRaw, "bpf-raw", "", "raw BPF program in 'tcpdump -ddd' format, lines might be separated with commas")
+	pcapCmd.Flags().DurationVar(&pcapCmdFlags.duration, "duration", 0, fmt.Sprintf("duration of the capture, zero captures until interrupted (%s at most)", constants.MaxPacketCaptureDuration))
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.output, "output", "o", "-", "path to the output file, '-' writes to stdout")
+}
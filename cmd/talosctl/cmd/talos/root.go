@@ -22,10 +22,11 @@ var (
 
 // Common options set on root command.
 var (
-	Talosconfig string
-	Endpoints   []string
-	Nodes       []string
-	Cmdcontext  string
+	Talosconfig   string
+	Endpoints     []string
+	Nodes         []string
+	NodesSelector string
+	Cmdcontext    string
 )
 
 // WithClientNoNodes wraps common code to initialize Talos client and provide cancellable context.
@@ -58,6 +59,8 @@ func WithClientNoNodes(action func(context.Context, *client.Client) error) error
 		// nolint: errcheck
 		defer c.Close()
 
+		warnClientCertificateExpiry(c.GetConfigContext())
+
 		return action(ctx, c)
 	})
 }
@@ -65,6 +68,16 @@ func WithClientNoNodes(action func(context.Context, *client.Client) error) error
 // WithClient builds upon WithClientNoNodes to provide set of nodes on request context based on config & flags.
 func WithClient(action func(context.Context, *client.Client) error) error {
 	return WithClientNoNodes(func(ctx context.Context, c *client.Client) error {
+		if NodesSelector != "" {
+			if len(Nodes) > 0 {
+				return fmt.Errorf("`--nodes` and `--nodes-selector` flags can't be used together")
+			}
+
+			ctx = client.WithNodesSelector(ctx, NodesSelector)
+
+			return action(ctx, c)
+		}
+
 		if len(Nodes) < 1 {
 			configContext := c.GetConfigContext()
 			if configContext == nil {
@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/cluster/rotate"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+)
+
+var rotateCACmdFlags struct {
+	clusterState   clusterNodes
+	statePath      string
+	kubeconfigPath string
+	talos          bool
+	kubernetes     bool
+	until          string
+	nodeTimeout    time.Duration
+}
+
+// rotateCACmd represents the rotate-ca command.
+var rotateCACmd = &cobra.Command{
+	Use:   "rotate-ca",
+	Short: "Rotate Talos and Kubernetes certificate authorities of the cluster",
+	Long: `Rotation introduces new CA alongside the old one, reissues node certificates and switches
+to the new CA, updating talosconfig (and optionally kubeconfig), and finally drops the old CA.
+
+Every node is rebooted on each step. Progress is saved to the state file, and
+re-running the command with the same state file (and the same --talos and --kubernetes flags)
+resumes the rotation.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), rotateCA)
+	},
+}
+
+func rotateCA(ctx context.Context) error {
+	cfg, err := clientconfig.Open(Talosconfig)
+	if err != nil {
+		return fmt.Errorf("failed to open config file %q: %w", Talosconfig, err)
+	}
+
+	return rotate.CA(ctx, &rotateCACmdFlags.clusterState, rotate.Options{
+		TalosConfig:        cfg,
+		TalosConfigPath:    Talosconfig,
+		ContextName:        Cmdcontext,
+		KubeconfigPath:     rotateCACmdFlags.kubeconfigPath,
+		StatePath:          rotateCACmdFlags.statePath,
+		RotateTalosCA:      rotateCACmdFlags.talos,
+		RotateKubernetesCA: rotateCACmdFlags.kubernetes,
+		Until:              rotate.Step(rotateCACmdFlags.until),
+		NodeTimeout:        rotateCACmdFlags.nodeTimeout,
+		Log: func(format string, args ...interface{}) {
+			fmt.Fprintf(os.Stderr, format+"\n", args...)
+		},
+	})
+}
+
+func init() {
+	addCommand(rotateCACmd)
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.clusterState.InitNode, "init-node", "", "specify IPs of init node")
+	rotateCACmd.Flags().StringSliceVar(&rotateCACmdFlags.clusterState.ControlPlaneNodes, "control-plane-nodes", nil, "specify IPs of control plane nodes")
+	rotateCACmd.Flags().StringSliceVar(&rotateCACmdFlags.clusterState.WorkerNodes, "worker-nodes", nil, "specify IPs of worker nodes")
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.statePath, "state", "rotate-ca.yaml", "path to the rotation state file (contains CA keys)")
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.kubeconfigPath, "kubeconfig", "", "path to write the admin kubeconfig issued by the new Kubernetes CA")
+	rotateCACmd.Flags().BoolVar(&rotateCACmdFlags.talos, "talos", true, "rotate Talos API CA")
+	rotateCACmd.Flags().BoolVar(&rotateCACmdFlags.kubernetes, "kubernetes", true, "rotate Kubernetes API CA")
+	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.until, "until", "", "stop after the step is completed (prepare, trust, switch, drop)")
+	rotateCACmd.Flags().DurationVar(&rotateCACmdFlags.nodeTimeout, "node-timeout", 10*time.Minute, "timeout to wait for a node to reboot")
+}
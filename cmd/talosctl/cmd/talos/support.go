@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cluster/support"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var supportCmdFlags struct {
+	output     string
+	numWorkers int
+	logLines   int32
+}
+
+// supportCmd represents the support command.
+var supportCmd = &cobra.Command{
+	Use:   "support",
+	Short: "Collect the support bundle from the nodes",
+	Long: `Collect the support bundle from the nodes into a single zip archive.
+
+For each node, the support bundle contains service logs, kernel logs, events, list of services,
+mounts, processes, resources and the machine configuration with the secrets redacted.
+Additionally, summary of the Kubernetes nodes and pods and logs of the control plane pods are collected.
+
+Errors encountered while collecting the information are written to the archive as well.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if NodesSelector != "" {
+				return fmt.Errorf("`--nodes-selector` is not supported, please use `--nodes` to specify the nodes")
+			}
+
+			f, err := os.Create(supportCmdFlags.output)
+			if err != nil {
+				return fmt.Errorf("error creating output file: %w", err)
+			}
+
+			//nolint: errcheck
+			defer f.Close()
+
+			opts := support.DefaultOptions()
+			opts.Nodes = Nodes
+			opts.Progress = os.Stderr
+			opts.NumWorkers = supportCmdFlags.numWorkers
+			opts.LogLines = supportCmdFlags.logLines
+
+			if err = support.Collect(ctx, c, f, opts); err != nil {
+				return fmt.Errorf("error writing support bundle: %w", err)
+			}
+
+			if err = f.Close(); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "support bundle is written to %q\n", supportCmdFlags.output)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	addCommand(supportCmd)
+	supportCmd.Flags().StringVarP(&supportCmdFlags.output, "output", "O", "support.zip", "path to the output archive")
+	supportCmd.Flags().IntVarP(&supportCmdFlags.numWorkers, "num-workers", "w", support.DefaultOptions().NumWorkers, "number of nodes to collect the information from concurrently")
+	supportCmd.Flags().Int32Var(&supportCmdFlags.logLines, "log-lines", support.DefaultOptions().LogLines, "number of log lines to collect from each log, -1 collects all the lines")
+}
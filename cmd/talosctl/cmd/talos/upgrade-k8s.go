@@ -21,8 +21,17 @@ import (
 var upgradeK8sCmd = &cobra.Command{
 	Use:   "upgrade-k8s",
 	Short: "Upgrade Kubernetes control plane in the Talos cluster.",
-	Long:  `Command runs upgrade of Kubernetes control plane components between specified versions. Pod-checkpointer is handled in a special way to speed up kube-apisever upgrades.`,
-	Args:  cobra.NoArgs,
+	Long: `Command runs upgrade of Kubernetes control plane components between specified versions.
+
+Self-hosted control plane is upgraded by updating control plane daemonsets. Pod-checkpointer is handled in a special way to speed up kube-apisever upgrades.
+
+Control plane managed by Talos is upgraded by patching machine configuration of the nodes one by one:
+control plane nodes get new images for the control plane components, and all nodes get new kubelet image.
+Only the tag of the images is changed, and the images are expected to match the --from version.
+Component versions are verified once every node is upgraded.
+
+Use --dry-run to see the planned changes without applying them.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(upgradeKubernetes)
 	},
@@ -35,6 +44,7 @@ func init() {
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.ToVersion, "to", constants.DefaultKubernetesVersion, "the Kubernetes control plane version to upgrade to")
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.Architecture, "arch", runtime.GOARCH, "the cluster architecture")
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.ControlPlaneEndpoint, "endpoint", "", "the cluster control plane endpoint")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.DryRun, "dry-run", false, "print the planned changes without applying them")
 	cli.Should(upgradeK8sCmd.MarkFlagRequired("from"))
 	cli.Should(upgradeK8sCmd.MarkFlagRequired("to"))
 	addCommand(upgradeK8sCmd)
@@ -47,8 +57,10 @@ func upgradeKubernetes(ctx context.Context, c *client.Client) error {
 	defer clientProvider.Close() //nolint: errcheck
 
 	state := struct {
+		cluster.ClientProvider
 		cluster.K8sProvider
 	}{
+		ClientProvider: clientProvider,
 		K8sProvider: &cluster.KubernetesClient{
 			ClientProvider: clientProvider,
 			ForceEndpoint:  healthCmdFlags.forceEndpoint,
@@ -16,6 +16,9 @@ import (
 	"google.golang.org/grpc/peer"
 
 	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	clusterupgrade "github.com/talos-systems/talos/pkg/cluster/upgrade"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
@@ -25,13 +28,29 @@ var (
 	stage        bool
 )
 
+var upgradeCmdFlags struct {
+	cluster       bool
+	clusterState  clusterNodes
+	batchSize     int
+	statePath     string
+	nodeTimeout   time.Duration
+	waitTimeout   time.Duration
+	forceEndpoint string
+}
+
 // upgradeCmd represents the processes command.
 var upgradeCmd = &cobra.Command{
 	Use:   "upgrade",
 	Short: "Upgrade Talos on the target node",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `With --cluster, all the nodes of the cluster are upgraded: control plane nodes one at a time,
+followed by worker nodes in batches. Cluster health is checked before the upgrade and after every step.
+Progress is saved to the state file, an interrupted upgrade is resumed by re-running the command.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if upgradeCmdFlags.cluster {
+			return WithClientNoNodes(upgradeCluster)
+		}
+
 		return upgrade()
 	},
 }
@@ -40,6 +59,15 @@ func init() {
 	upgradeCmd.Flags().StringVarP(&upgradeImage, "image", "i", "", "the container image to use for performing the install")
 	upgradeCmd.Flags().BoolVarP(&preserve, "preserve", "p", false, "preserve data")
 	upgradeCmd.Flags().BoolVarP(&stage, "stage", "s", false, "stage the upgrade to perform it after a reboot")
+	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.cluster, "cluster", false, "upgrade all the nodes of the cluster")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.clusterState.InitNode, "init-node", "", "specify IPs of init node")
+	upgradeCmd.Flags().StringSliceVar(&upgradeCmdFlags.clusterState.ControlPlaneNodes, "control-plane-nodes", nil, "specify IPs of control plane nodes")
+	upgradeCmd.Flags().StringSliceVar(&upgradeCmdFlags.clusterState.WorkerNodes, "worker-nodes", nil, "specify IPs of worker nodes")
+	upgradeCmd.Flags().IntVar(&upgradeCmdFlags.batchSize, "batch-size", 1, "number of worker nodes to upgrade at once")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.statePath, "state", "upgrade.yaml", "path to the cluster upgrade state file")
+	upgradeCmd.Flags().DurationVar(&upgradeCmdFlags.nodeTimeout, "node-timeout", 15*time.Minute, "timeout to wait for a node to reboot")
+	upgradeCmd.Flags().DurationVar(&upgradeCmdFlags.waitTimeout, "wait-timeout", 20*time.Minute, "timeout to wait for the cluster to be healthy after each step")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
 	addCommand(upgradeCmd)
 }
 
@@ -76,3 +104,37 @@ func upgrade() error {
 		return w.Flush()
 	})
 }
+
+func upgradeCluster(ctx context.Context, c *client.Client) error {
+	clientProvider := &cluster.ConfigClientProvider{
+		DefaultClient: c,
+	}
+	defer clientProvider.Close() //nolint: errcheck
+
+	state := struct {
+		cluster.ClientProvider
+		cluster.K8sProvider
+		cluster.Info
+	}{
+		ClientProvider: clientProvider,
+		K8sProvider: &cluster.KubernetesClient{
+			ClientProvider: clientProvider,
+			ForceEndpoint:  upgradeCmdFlags.forceEndpoint,
+		},
+		Info: &upgradeCmdFlags.clusterState,
+	}
+
+	return clusterupgrade.Cluster(ctx, &state, clusterupgrade.Options{
+		Image:           upgradeImage,
+		Preserve:        preserve,
+		Stage:           stage,
+		WorkerBatchSize: upgradeCmdFlags.batchSize,
+		StatePath:       upgradeCmdFlags.statePath,
+		NodeTimeout:     upgradeCmdFlags.nodeTimeout,
+		CheckTimeout:    upgradeCmdFlags.waitTimeout,
+		Reporter:        check.StderrReporter(),
+		Log: func(format string, args ...interface{}) {
+			fmt.Fprintf(os.Stderr, format+"\n", args...)
+		},
+	})
+}
@@ -18,7 +18,7 @@ func FailIfMultiNodes(ctx context.Context, command string) error {
 		return nil
 	}
 
-	if len(md.Get("nodes")) <= 1 {
+	if len(md.Get("selector")) == 0 && len(md.Get("nodes")) <= 1 {
 		return nil
 	}
 
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"sync"
+
+	"github.com/fatih/color"
+)
+
+var nodeColors = []color.Attribute{
+	color.FgCyan,
+	color.FgGreen,
+	color.FgYellow,
+	color.FgBlue,
+	color.FgMagenta,
+	color.FgHiCyan,
+	color.FgHiGreen,
+	color.FgHiYellow,
+	color.FgHiBlue,
+	color.FgHiMagenta,
+}
+
+// NodeColorizer colorizes node names in the output of multi-node commands.
+//
+// Each node gets its own color in order of appearance, colors are disabled
+// if the output is not a terminal.
+type NodeColorizer struct {
+	mu     sync.Mutex
+	colors map[string]*color.Color
+}
+
+// NewNodeColorizer initializes NodeColorizer.
+func NewNodeColorizer() *NodeColorizer {
+	return &NodeColorizer{
+		colors: map[string]*color.Color{},
+	}
+}
+
+// Node returns colorized node name.
+func (colorizer *NodeColorizer) Node(node string) string {
+	colorizer.mu.Lock()
+	defer colorizer.mu.Unlock()
+
+	c, ok := colorizer.colors[node]
+	if !ok {
+		c = color.New(nodeColors[len(colorizer.colors)%len(nodeColors)])
+		colorizer.colors[node] = c
+	}
+
+	return c.Sprint(node)
+}
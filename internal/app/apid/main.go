@@ -17,9 +17,9 @@ import (
 
 	apidbackend "github.com/talos-systems/talos/internal/app/apid/pkg/backend"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
+	"github.com/talos-systems/talos/internal/app/apid/pkg/membership"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
-	"github.com/talos-systems/talos/pkg/grpc/proxy/backend"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/startup"
@@ -61,9 +61,10 @@ func main() {
 	}
 
 	backendFactory := apidbackend.NewAPIDFactory(clientTLSConfig)
-	localBackend := backend.NewLocal("routerd", constants.RouterdSocketPath)
+	localBackend := apidbackend.NewLocal("routerd", constants.RouterdSocketPath)
 
 	router := director.NewRouter(backendFactory.Get, localBackend)
+	router.SetNodeResolver(membership.NewMachinedResolver(constants.RouterdSocketPath, membership.DefaultCacheTTL))
 
 	// all existing streaming methods
 	for _, methodName := range []string{
@@ -71,14 +72,19 @@ func main() {
 		"/machine.MachineService/DiskUsage",
 		"/machine.MachineService/Dmesg",
 		"/machine.MachineService/Events",
+		"/machine.MachineService/ImageList",
+		"/machine.MachineService/ImagePull",
 		"/machine.MachineService/Kubeconfig",
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
+		"/machine.MachineService/PacketCapture",
 		"/machine.MachineService/Read",
+		"/network.NetworkService/Events",
 		"/resource.ResourceService/List",
 		"/resource.ResourceService/Watch",
 		"/os.OSService/Dmesg",
 		"/cluster.ClusterService/HealthCheck",
+		"/time.TimeService/Events",
 	} {
 		router.RegisterStreamedRegex("^" + regexp.QuoteMeta(methodName) + "$")
 	}
@@ -86,6 +92,8 @@ func main() {
 	// register future pattern: method should have suffix "Stream"
 	router.RegisterStreamedRegex("Stream$")
 
+	backendFactory.SetStreamedDetector(router.StreamedDetector)
+
 	var errGroup errgroup.Group
 
 	errGroup.Go(func() error {
@@ -6,7 +6,9 @@ package backend
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"sync"
 
 	"github.com/talos-systems/grpc-proxy/proxy"
@@ -18,6 +20,7 @@ import (
 	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/identity"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -26,8 +29,9 @@ import (
 //
 // Backend authenticates itself using given grpc credentials.
 type APID struct {
-	target string
-	creds  credentials.TransportCredentials
+	target   string
+	creds    credentials.TransportCredentials
+	streamed func(fullMethodName string) bool
 
 	mu   sync.Mutex
 	conn *grpc.ClientConn
@@ -56,8 +60,12 @@ func (a *APID) GetConnection(ctx context.Context) (context.Context, *grpc.Client
 
 	md := origMd.Copy()
 	delete(md, "nodes")
+	delete(md, "selector")
 	delete(md, ":authority")
 
+	// remote apid ignores the forwarded identity anyway, but don't leak it
+	identity.Strip(md)
+
 	if ok {
 		md.Set("proxyfrom", origMd[":authority"]...)
 	} else {
@@ -79,11 +87,61 @@ func (a *APID) GetConnection(ctx context.Context) (context.Context, *grpc.Client
 		fmt.Sprintf("%s:%d", net.FormatAddress(a.target), constants.ApidPort),
 		grpc.WithTransportCredentials(a.creds),
 		grpc.WithCodec(proxy.Codec()), //nolint: staticcheck
+		grpc.WithStreamInterceptor(a.streamInterceptor),
 	)
 
 	return outCtx, a.conn, err
 }
 
+// streamInterceptor replaces the error of the streaming call with the error frame for the target.
+//
+// When one of the nodes fails in the middle of the streaming call, response stream of the client
+// should carry the error for that node, while the responses from other nodes keep flowing.
+func (a *APID) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.streamed == nil || !a.streamed(method) {
+		return stream, nil
+	}
+
+	return &errorFrameStream{
+		ClientStream: stream,
+		backend:      a,
+	}, nil
+}
+
+// errorFrameStream converts the error received from the upstream into the error frame followed by io.EOF.
+type errorFrameStream struct {
+	grpc.ClientStream
+
+	backend *APID
+	failed  bool
+}
+
+func (s *errorFrameStream) RecvMsg(m interface{}) error {
+	if s.failed {
+		return io.EOF
+	}
+
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || errors.Is(err, io.EOF) {
+		return err
+	}
+
+	s.failed = true
+
+	payload, buildErr := s.backend.BuildError(true, err)
+	if buildErr != nil {
+		return err
+	}
+
+	return proxy.Codec().Unmarshal(payload, m)
+}
+
 // AppendInfo is called to enhance response from the backend with additional data.
 //
 // AppendInfo enhances upstream response with node metadata (target).
@@ -16,8 +16,9 @@ import (
 //
 // TODO: need to clean up idle connections from time to time.
 type APIDFactory struct {
-	cache sync.Map
-	creds credentials.TransportCredentials
+	cache    sync.Map
+	creds    credentials.TransportCredentials
+	streamed func(fullMethodName string) bool
 }
 
 // NewAPIDFactory creates new APIDFactory with given tls.Config.
@@ -29,6 +30,14 @@ func NewAPIDFactory(config *tls.Config) *APIDFactory {
 	}
 }
 
+// SetStreamedDetector sets the function which detects streaming methods.
+//
+// Errors of the streaming calls are converted to the error frames for the failed backend.
+// SetStreamedDetector should be called before the first backend is built.
+func (factory *APIDFactory) SetStreamedDetector(streamed func(fullMethodName string) bool) {
+	factory.streamed = streamed
+}
+
 // Get backend by target.
 //
 // Get performs caching of backends.
@@ -43,6 +52,8 @@ func (factory *APIDFactory) Get(target string) (proxy.Backend, error) {
 		return nil, err
 	}
 
+	backend.streamed = factory.streamed
+
 	existing, loaded := factory.cache.LoadOrStore(target, backend)
 	if loaded {
 		// race: another Get() call built different backend
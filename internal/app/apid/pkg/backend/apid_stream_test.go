@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+)
+
+// failingStream returns the messages and fails with the error.
+type failingStream struct {
+	grpc.ClientStream
+
+	messages int
+	err      error
+}
+
+func (s *failingStream) RecvMsg(m interface{}) error {
+	if s.messages == 0 {
+		return s.err
+	}
+
+	s.messages--
+
+	return nil
+}
+
+func newFailingStream(t *testing.T, method string, stream *failingStream) grpc.ClientStream {
+	a, err := NewAPID("127.0.0.1", credentials.NewTLS(&tls.Config{}))
+	require.NoError(t, err)
+
+	a.streamed = func(fullMethodName string) bool {
+		return fullMethodName == "/machine.MachineService/Logs"
+	}
+
+	s, err := a.streamInterceptor(context.Background(), &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, nil, method,
+		func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return stream, nil
+		})
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestStreamErrorFrame(t *testing.T) {
+	s := newFailingStream(t, "/machine.MachineService/Logs", &failingStream{
+		messages: 2,
+		err:      status.Error(codes.Unavailable, "transport is closing"),
+	})
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, s.RecvMsg(&common.Empty{}))
+	}
+
+	// error in the middle of the stream is sent as the error frame of the node
+	var msg common.Empty
+
+	require.NoError(t, s.RecvMsg(&msg))
+
+	assert.Equal(t, "127.0.0.1", msg.Metadata.Hostname)
+	assert.Equal(t, "rpc error: code = Unavailable desc = transport is closing", msg.Metadata.Error)
+	assert.EqualValues(t, codes.Unavailable, msg.Metadata.Status.Code)
+
+	// and the stream of the node is finished
+	assert.Equal(t, io.EOF, s.RecvMsg(&common.Empty{}))
+}
+
+func TestStreamEOF(t *testing.T) {
+	s := newFailingStream(t, "/machine.MachineService/Logs", &failingStream{
+		messages: 1,
+		err:      io.EOF,
+	})
+
+	require.NoError(t, s.RecvMsg(&common.Empty{}))
+	assert.Equal(t, io.EOF, s.RecvMsg(&common.Empty{}))
+}
+
+func TestUnaryError(t *testing.T) {
+	upstreamErr := status.Error(codes.Unavailable, "transport is closing")
+
+	s := newFailingStream(t, "/machine.MachineService/Version", &failingStream{
+		err: upstreamErr,
+	})
+
+	// errors of the unary calls are handled by the proxy
+	assert.Equal(t, upstreamErr, s.RecvMsg(&common.Empty{}))
+}
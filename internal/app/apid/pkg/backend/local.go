@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/identity"
+	proxybackend "github.com/talos-systems/talos/pkg/grpc/proxy/backend"
+)
+
+// Local backend proxies requests to the local services.
+//
+// Identity of the verified client certificate is passed on to the local services.
+// Requests proxied from another apid instance are authenticated with the node certificate,
+// so the client identity is not known for them.
+type Local struct {
+	*proxybackend.Local
+}
+
+// NewLocal builds new Local backend.
+func NewLocal(name, socketPath string) *Local {
+	return &Local{
+		Local: proxybackend.NewLocal(name, socketPath),
+	}
+}
+
+// GetConnection returns a grpc connection to the backend.
+func (l *Local) GetConnection(ctx context.Context) (context.Context, *grpc.ClientConn, error) {
+	outCtx, conn, err := l.Local.GetConnection(ctx)
+
+	md, _ := metadata.FromOutgoingContext(outCtx)
+	md = md.Copy()
+
+	identity.Strip(md)
+
+	if _, proxied := md["proxyfrom"]; !proxied {
+		if id, ok := identity.FromPeer(ctx); ok {
+			identity.Set(md, id)
+		}
+	}
+
+	return metadata.NewOutgoingContext(ctx, md), conn, err
+}
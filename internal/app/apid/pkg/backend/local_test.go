@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backend_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/grpc-proxy/proxy"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/backend"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/identity"
+)
+
+func TestLocalInterfaces(t *testing.T) {
+	assert.Implements(t, (*proxy.Backend)(nil), new(backend.Local))
+}
+
+func peerContext(md metadata.MD) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{
+					{
+						{
+							Subject: pkix.Name{
+								CommonName:   "admin",
+								Organization: []string{"os:admin", "os:reader"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestLocalGetConnectionIdentity(t *testing.T) {
+	b := backend.NewLocal("routerd", "/nonexistent.sock")
+
+	// identity supplied by the client is replaced with the verified one
+	outCtx, _, err := b.GetConnection(peerContext(metadata.Pairs(
+		"talos-client-cn", "root",
+		"talos-client-roles", "os:root",
+		"key", "value",
+	)))
+	require.NoError(t, err)
+
+	id, ok := identity.FromIncomingContext(metadata.NewIncomingContext(context.Background(), outMD(t, outCtx)))
+	require.True(t, ok)
+	assert.Equal(t, &identity.Identity{CommonName: "admin", Roles: []string{"os:admin", "os:reader"}}, id)
+	assert.Equal(t, []string{"value"}, outMD(t, outCtx).Get("key"))
+
+	// request proxied from another apid is authenticated with the node certificate
+	outCtx, _, err = b.GetConnection(peerContext(metadata.Pairs(
+		"proxyfrom", "10.5.0.2",
+		"talos-client-cn", "root",
+	)))
+	require.NoError(t, err)
+
+	_, ok = identity.FromIncomingContext(metadata.NewIncomingContext(context.Background(), outMD(t, outCtx)))
+	assert.False(t, ok)
+
+	// no verified client certificate
+	outCtx, _, err = b.GetConnection(metadata.NewIncomingContext(context.Background(), metadata.Pairs("talos-client-cn", "root")))
+	require.NoError(t, err)
+
+	_, ok = identity.FromIncomingContext(metadata.NewIncomingContext(context.Background(), outMD(t, outCtx)))
+	assert.False(t, ok)
+}
+
+func outMD(t *testing.T, ctx context.Context) metadata.MD {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+
+	return md
+}
@@ -8,6 +8,7 @@ package director
 import (
 	"context"
 	"regexp"
+	"strings"
 
 	"github.com/talos-systems/grpc-proxy/proxy"
 	"google.golang.org/grpc"
@@ -20,6 +21,7 @@ import (
 type Router struct {
 	localBackend         proxy.Backend
 	remoteBackendFactory RemoteBackendFactory
+	nodeResolver         NodeResolver
 	streamedMatchers     []*regexp.Regexp
 }
 
@@ -40,6 +42,13 @@ func NewRouter(backendFactory RemoteBackendFactory, localBackend proxy.Backend)
 func (r *Router) Register(srv *grpc.Server) {
 }
 
+// SetNodeResolver sets the resolver for the node selectors.
+//
+// If the resolver is not set, requests with node selector are rejected.
+func (r *Router) SetNodeResolver(resolver NodeResolver) {
+	r.nodeResolver = resolver
+}
+
 // Director implements proxy.StreamDirector function.
 func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mode, []proxy.Backend, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -51,9 +60,24 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 		return proxy.One2One, []proxy.Backend{r.localBackend}, nil
 	}
 
-	var targets []string
+	targets, hasNodes := md["nodes"]
 
-	if targets, ok = md["nodes"]; !ok {
+	if selectors, exists := md["selector"]; exists {
+		if hasNodes {
+			return proxy.One2Many, nil, status.Error(codes.InvalidArgument, "nodes and node selector can't be used together")
+		}
+
+		var err error
+
+		targets, err = r.resolveNodes(ctx, selectors)
+		if err != nil {
+			return proxy.One2Many, nil, err
+		}
+
+		return r.aggregateDirector(targets)
+	}
+
+	if !hasNodes {
 		// send directly to local node, skips another layer of proxying
 		return proxy.One2One, []proxy.Backend{r.localBackend}, nil
 	}
@@ -61,6 +85,47 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 	return r.aggregateDirector(targets)
 }
 
+// resolveNodes resolves node selectors to the list of targets.
+//
+// Targets matched by several selectors are returned once.
+func (r *Router) resolveNodes(ctx context.Context, selectors []string) ([]string, error) {
+	if r.nodeResolver == nil {
+		return nil, status.Error(codes.Unimplemented, "node selectors are not supported")
+	}
+
+	var targets []string
+
+	seen := map[string]struct{}{}
+
+	for _, sel := range selectors {
+		selector, err := ParseNodeSelector(sel)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		nodes, err := r.nodeResolver.ResolveNodes(ctx, selector)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "error resolving node selector %q: %s", selector, err)
+		}
+
+		for _, node := range nodes {
+			if _, exists := seen[node]; exists {
+				continue
+			}
+
+			seen[node] = struct{}{}
+
+			targets = append(targets, node)
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no nodes match node selector %q", strings.Join(selectors, ","))
+	}
+
+	return targets, nil
+}
+
 // aggregateDirector sends request across set of remote instances and aggregates results.
 func (r *Router) aggregateDirector(targets []string) (proxy.Mode, []proxy.Backend, error) {
 	var err error
@@ -9,9 +9,12 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/talos-systems/grpc-proxy/proxy"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
 )
@@ -62,6 +65,79 @@ func (suite *DirectorSuite) TestDirectorAggregate() {
 	suite.Assert().NoError(err)
 }
 
+func (suite *DirectorSuite) TestDirectorSelector() {
+	ctx := context.Background()
+
+	md := metadata.New(nil)
+	md.Set("selector", "all")
+	_, _, err := suite.router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(codes.Unimplemented, status.Code(err))
+
+	router := director.NewRouter(mockBackendFactory, &mockBackend{})
+	router.SetNodeResolver(&mockResolver{
+		nodes: map[director.NodeRole][]string{
+			director.NodeRoleControlPlane: {"127.0.0.1"},
+			director.NodeRoleWorker:       {"127.0.0.2", "127.0.0.3"},
+		},
+	})
+
+	mode, backends, err := router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(proxy.One2Many, mode)
+	suite.Assert().Len(backends, 3)
+
+	md = metadata.New(nil)
+	md.Set("selector", "role=worker", "all")
+	_, backends, err = router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Require().NoError(err)
+	suite.Require().Len(backends, 3)
+	suite.Assert().Equal("127.0.0.2", backends[0].(*mockBackend).target)
+	suite.Assert().Equal("127.0.0.3", backends[1].(*mockBackend).target)
+	suite.Assert().Equal("127.0.0.1", backends[2].(*mockBackend).target)
+
+	md = metadata.New(nil)
+	md.Set("selector", "role=controlplane")
+	md.Set("nodes", "127.0.0.1")
+	_, _, err = router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	md = metadata.New(nil)
+	md.Set("selector", "role=etcd")
+	_, _, err = router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	router.SetNodeResolver(&mockResolver{})
+
+	md = metadata.New(nil)
+	md.Set("selector", "role=worker")
+	_, _, err = router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+func TestParseNodeSelector(t *testing.T) {
+	for _, test := range []struct {
+		selector string
+		expected director.NodeSelector
+		err      string
+	}{
+		{selector: "all", expected: director.NodeSelector{}},
+		{selector: "role=controlplane", expected: director.NodeSelector{Role: director.NodeRoleControlPlane}},
+		{selector: " role = worker ", expected: director.NodeSelector{Role: director.NodeRoleWorker}},
+		{selector: "role=etcd", err: "unsupported node role \"etcd\""},
+		{selector: "zone=a", err: "unsupported node selector key \"zone\""},
+		{selector: "workers", err: "invalid node selector \"workers\""},
+	} {
+		selector, err := director.ParseNodeSelector(test.selector)
+
+		if test.err != "" {
+			assert.EqualError(t, err, test.err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, selector)
+		}
+	}
+}
+
 func TestDirectorSuite(t *testing.T) {
 	suite.Run(t, new(DirectorSuite))
 }
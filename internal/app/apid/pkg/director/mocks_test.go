@@ -9,6 +9,8 @@ import (
 
 	"github.com/talos-systems/grpc-proxy/proxy"
 	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
 )
 
 type mockBackend struct {
@@ -34,3 +36,15 @@ func (m *mockBackend) BuildError(streaming bool, err error) ([]byte, error) {
 func mockBackendFactory(target string) (proxy.Backend, error) {
 	return &mockBackend{target: target}, nil
 }
+
+type mockResolver struct {
+	nodes map[director.NodeRole][]string
+}
+
+func (m *mockResolver) ResolveNodes(ctx context.Context, selector director.NodeSelector) ([]string, error) {
+	if selector.Role != "" {
+		return m.nodes[selector.Role], nil
+	}
+
+	return append(append([]string(nil), m.nodes[director.NodeRoleControlPlane]...), m.nodes[director.NodeRoleWorker]...), nil
+}
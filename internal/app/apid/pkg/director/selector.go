@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package director
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// NodeRole is a role of the node in the cluster.
+type NodeRole string
+
+// Node roles which can be used in the selector.
+const (
+	NodeRoleControlPlane NodeRole = "controlplane"
+	NodeRoleWorker       NodeRole = "worker"
+)
+
+// NodeSelector selects a set of cluster nodes.
+//
+// Empty Role selects all the nodes.
+type NodeSelector struct {
+	Role NodeRole
+}
+
+// NodeResolver resolves node selector to the list of node addresses.
+type NodeResolver interface {
+	ResolveNodes(ctx context.Context, selector NodeSelector) ([]string, error)
+}
+
+// ParseNodeSelector parses node selector.
+//
+// Supported selectors are: "all", "role=controlplane" and "role=worker".
+func ParseNodeSelector(selector string) (NodeSelector, error) {
+	selector = strings.TrimSpace(selector)
+
+	if selector == "all" {
+		return NodeSelector{}, nil
+	}
+
+	parts := strings.SplitN(selector, "=", 2)
+	if len(parts) != 2 {
+		return NodeSelector{}, fmt.Errorf("invalid node selector %q", selector)
+	}
+
+	key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+	if key != "role" {
+		return NodeSelector{}, fmt.Errorf("unsupported node selector key %q", key)
+	}
+
+	switch role := NodeRole(value); role {
+	case NodeRoleControlPlane, NodeRoleWorker:
+		return NodeSelector{Role: role}, nil
+	default:
+		return NodeSelector{}, fmt.Errorf("unsupported node role %q", value)
+	}
+}
+
+func (selector NodeSelector) String() string {
+	if selector.Role == "" {
+		return "all"
+	}
+
+	return fmt.Sprintf("role=%s", selector.Role)
+}
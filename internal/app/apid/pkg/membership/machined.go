@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package membership provides cluster membership information for apid.
+package membership
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// DefaultCacheTTL is the default duration the list of cluster nodes is cached for.
+const DefaultCacheTTL = 30 * time.Second
+
+// MachinedResolver resolves node selectors using the cluster members reported by machined.
+//
+// machined lists the Kubernetes nodes with the kubelet credentials, so apid doesn't need
+// access to them.
+type MachinedResolver struct {
+	socketPath string
+	cacheTTL   time.Duration
+
+	mu        sync.Mutex
+	conn      *grpc.ClientConn
+	members   []*machine.ClusterMember
+	fetchedAt time.Time
+}
+
+// NewMachinedResolver creates new MachinedResolver which talks to machined via the socket.
+func NewMachinedResolver(socketPath string, cacheTTL time.Duration) *MachinedResolver {
+	return &MachinedResolver{
+		socketPath: socketPath,
+		cacheTTL:   cacheTTL,
+	}
+}
+
+// ResolveNodes implements director.NodeResolver interface.
+func (resolver *MachinedResolver) ResolveNodes(ctx context.Context, selector director.NodeSelector) ([]string, error) {
+	members, err := resolver.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []string
+
+	for _, m := range members {
+		if selector.Role != "" && selector.Role != director.NodeRole(m.Role) {
+			continue
+		}
+
+		nodes = append(nodes, m.Address)
+	}
+
+	return nodes, nil
+}
+
+func (resolver *MachinedResolver) list(ctx context.Context) ([]*machine.ClusterMember, error) {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	if resolver.members != nil && time.Since(resolver.fetchedAt) < resolver.cacheTTL {
+		return resolver.members, nil
+	}
+
+	if resolver.conn == nil {
+		conn, err := grpc.DialContext(ctx, "unix:"+resolver.socketPath, grpc.WithInsecure())
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to machined: %w", err)
+		}
+
+		resolver.conn = conn
+	}
+
+	resp, err := machine.NewMachineServiceClient(resolver.conn).ClusterMembers(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing cluster members: %w", err)
+	}
+
+	members := []*machine.ClusterMember{}
+
+	for _, msg := range resp.Messages {
+		members = append(members, msg.Members...)
+	}
+
+	resolver.members = members
+	resolver.fetchedAt = time.Now()
+
+	return members, nil
+}
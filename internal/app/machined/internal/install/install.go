@@ -23,6 +23,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
+	"github.com/talos-systems/talos/pkg/images"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -67,6 +68,16 @@ func RunInstallerContainer(disk, platform, ref string, reg config.Registries, op
 		{Type: "bind", Destination: "/dev", Source: "/dev", Options: []string{"rbind", "rshared", "rw"}},
 	}
 
+	if options.ImageCacheSource != "" {
+		// image cache archives are read from the host instead of the ones bundled into the installer image
+		mounts = append(mounts, specs.Mount{
+			Type:        "bind",
+			Destination: constants.ImageCacheSourcePath,
+			Source:      options.ImageCacheSource,
+			Options:     []string{"bind", "ro"},
+		})
+	}
+
 	// TODO(andrewrynhard): To handle cases when the newer version changes the
 	// platform name, this should be determined in the installer container.
 	config := constants.ConfigNone
@@ -97,6 +108,10 @@ func RunInstallerContainer(disk, platform, ref string, reg config.Registries, op
 		args = append(args, []string{"--extra-kernel-arg", arg}...)
 	}
 
+	for _, cached := range options.ImageCache {
+		args = append(args, "--image-cache="+cached.String())
+	}
+
 	specOpts := []oci.SpecOpts{
 		oci.WithImageConfig(img),
 		oci.WithProcessArgs(args...),
@@ -158,10 +173,30 @@ func RunInstallerContainer(disk, platform, ref string, reg config.Registries, op
 
 // OptionsFromUpgradeRequest builds installer options from upgrade request.
 func OptionsFromUpgradeRequest(r runtime.Runtime, in *machineapi.UpgradeRequest) []Option {
-	return []Option{
+	opts := []Option{
 		WithPull(false),
 		WithUpgrade(true),
 		WithForce(!in.GetPreserve()),
 		WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
 	}
+
+	// image cache partition is re-created only if the disk is wiped, with preserve
+	// the images are already in the containerd content store on the ephemeral partition
+	if !in.GetPreserve() {
+		opts = append(opts,
+			WithImageCache(ImageCacheList(r.Config())),
+			WithImageCacheSource(r.Config().Machine().Install().ImageCache().Path()),
+		)
+	}
+
+	return opts
+}
+
+// ImageCacheList returns the list of images to be cached at install time.
+func ImageCacheList(config config.Provider) []images.CachedImage {
+	if !config.Machine().Install().ImageCache().Enabled() {
+		return nil
+	}
+
+	return images.CacheList(config)
 }
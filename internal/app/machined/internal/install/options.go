@@ -4,16 +4,20 @@
 
 package install
 
+import "github.com/talos-systems/talos/pkg/images"
+
 // Option is a functional option.
 type Option func(o *Options) error
 
 // Options describes the install options.
 type Options struct {
-	Pull            bool
-	Force           bool
-	Upgrade         bool
-	Zero            bool
-	ExtraKernelArgs []string
+	Pull             bool
+	Force            bool
+	Upgrade          bool
+	Zero             bool
+	ExtraKernelArgs  []string
+	ImageCache       []images.CachedImage
+	ImageCacheSource string
 }
 
 // DefaultInstallOptions returns default options.
@@ -87,3 +91,21 @@ func WithExtraKernelArgs(s []string) Option {
 		return nil
 	}
 }
+
+// WithImageCache sets the list of images to store in the image cache partition.
+func WithImageCache(cached []images.CachedImage) Option {
+	return func(o *Options) error {
+		o.ImageCache = cached
+
+		return nil
+	}
+}
+
+// WithImageCacheSource sets the path on the host to the image cache archives.
+func WithImageCacheSource(path string) Option {
+	return func(o *Options) error {
+		o.ImageCacheSource = path
+
+		return nil
+	}
+}
@@ -0,0 +1,237 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/talos-systems/talos/internal/pkg/eventlog"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// eventFilter implements server-side filtering of the events by type, name and time.
+type eventFilter struct {
+	types map[string]struct{}
+	names map[string]struct{}
+
+	since, until time.Time
+}
+
+func newEventFilter(req *machine.EventsRequest) *eventFilter {
+	filter := &eventFilter{}
+
+	if req.Since != nil {
+		filter.since = req.Since.AsTime()
+	}
+
+	if req.Until != nil {
+		filter.until = req.Until.AsTime()
+	}
+
+	if len(req.Types) > 0 {
+		filter.types = make(map[string]struct{}, len(req.Types))
+
+		for _, typ := range req.Types {
+			filter.types[typ] = struct{}{}
+		}
+	}
+
+	if len(req.Names) > 0 {
+		filter.names = make(map[string]struct{}, len(req.Names))
+
+		for _, name := range req.Names {
+			filter.names[name] = struct{}{}
+		}
+	}
+
+	return filter
+}
+
+// Matches checks the event with the ID and the payload.
+//
+// Time range is inclusive on both ends, event time is taken from the ID.
+func (filter *eventFilter) Matches(id xid.ID, payload proto.Message) bool {
+	switch {
+	case !filter.since.IsZero() && id.Time().Before(filter.since):
+		return false
+	case !filter.until.IsZero() && id.Time().After(filter.until):
+		return false
+	}
+
+	if filter.types != nil {
+		fullName := string(payload.ProtoReflect().Descriptor().FullName())
+		shortName := fullName[strings.LastIndex(fullName, ".")+1:]
+
+		_, fullOk := filter.types[fullName]
+		_, shortOk := filter.types[shortName]
+
+		if !fullOk && !shortOk {
+			return false
+		}
+	}
+
+	if filter.names != nil {
+		name, ok := eventName(payload)
+		if !ok {
+			return false
+		}
+
+		if _, ok = filter.names[name]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchesEvent checks serialized event, e.g. read from the event log.
+//
+// Malformed events never match.
+func (filter *eventFilter) MatchesEvent(event *machine.Event) bool {
+	id, err := xid.FromString(event.Id)
+	if err != nil {
+		return false
+	}
+
+	if filter.types == nil && filter.names == nil {
+		// payload is not used
+		return filter.Matches(id, nil)
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(event.GetData().GetTypeUrl())
+	if err != nil {
+		return false
+	}
+
+	payload := mt.New().Interface()
+
+	if err = proto.Unmarshal(event.GetData().GetValue(), payload); err != nil {
+		return false
+	}
+
+	return filter.Matches(id, payload)
+}
+
+// eventName returns the name of the event subject (sequence, service, etc.).
+func eventName(payload proto.Message) (string, bool) {
+	switch msg := payload.(type) {
+	case *machine.SequenceEvent:
+		return msg.Sequence, true
+	case *machine.PhaseEvent:
+		return msg.Phase, true
+	case *machine.TaskEvent:
+		return msg.Task, true
+	case *machine.ServiceStateEvent:
+		return msg.Service, true
+	case *machine.UpgradeTrialEvent:
+		return msg.Label, true
+	case *machine.AddressEvent:
+		return msg.Link, true
+	case *machine.EtcdMemberEvent:
+		return msg.Name, true
+	default:
+		return "", false
+	}
+}
+
+// resolveBootID returns the ID of the boot the request refers to.
+//
+// Event log is opened only if the request refers to the previous boots.
+func resolveBootID(req *machine.EventsRequest, currentBootID string, openEventLog func() (*eventlog.Log, error)) (string, error) {
+	switch {
+	case req.BootId != "" && req.Boot != 0:
+		return "", status.Error(codes.InvalidArgument, "boot_id and boot can't be specified at the same time")
+	case req.BootId != "":
+		return req.BootId, nil
+	case req.Boot > 0:
+		return "", status.Error(codes.InvalidArgument, "boot should be zero (current boot) or negative (previous boots)")
+	case req.Boot == 0:
+		return currentBootID, nil
+	}
+
+	eventLog, err := openEventLog()
+	if err != nil {
+		return "", err
+	}
+
+	boots, err := eventLog.Boots()
+	if err != nil {
+		return "", err
+	}
+
+	current := len(boots)
+
+	for i, bootID := range boots {
+		if bootID == currentBootID {
+			current = i
+		}
+	}
+
+	target := current + int(req.Boot)
+	if target < 0 {
+		return "", status.Error(codes.NotFound, fmt.Sprintf("boot %d is not available in the event log", req.Boot))
+	}
+
+	return boots[target], nil
+}
+
+// persistedEvents sends events of the previous boot from the event log.
+func persistedEvents(req *machine.EventsRequest, eventLog *eventlog.Log, bootID string, filter *eventFilter, send func(*machine.Event) error) error {
+	var (
+		err    error
+		tailID xid.ID
+	)
+
+	if req.TailId != "" {
+		tailID, err = xid.FromString(req.TailId)
+		if err != nil {
+			return fmt.Errorf("error parsing tail_id: %w", err)
+		}
+	}
+
+	var events []*machine.Event
+
+	if err = eventLog.Read(bootID, func(event *machine.Event) error {
+		id, parseErr := xid.FromString(event.Id)
+		if parseErr != nil {
+			// skip malformed events
+			return nil
+		}
+
+		if !tailID.IsNil() && id.Compare(tailID) <= 0 {
+			return nil
+		}
+
+		if !filter.MatchesEvent(event) {
+			return nil
+		}
+
+		events = append(events, event)
+
+		if req.TailEvents > 0 && len(events) > int(req.TailEvents) {
+			events = events[1:]
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error reading event log: %w", err)
+	}
+
+	for _, event := range events {
+		if err = send(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,388 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/talos-systems/talos/internal/pkg/eventlog"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func newTestEventLog(t *testing.T) (*eventlog.Log, func()) {
+	dir, err := ioutil.TempDir("", "eventlog")
+	require.NoError(t, err)
+
+	return eventlog.New(dir, 1024*1024), func() { os.RemoveAll(dir) } //nolint: errcheck
+}
+
+func newTestEvent(t *testing.T, id xid.ID, bootID string, payload proto.Message) *machine.Event {
+	value, err := proto.Marshal(payload)
+	require.NoError(t, err)
+
+	return &machine.Event{
+		Id:     id.String(),
+		BootId: bootID,
+		Data: &any.Any{
+			TypeUrl: fmt.Sprintf("talos/runtime/%s", payload.ProtoReflect().Descriptor().FullName()),
+			Value:   value,
+		},
+	}
+}
+
+func TestResolveBootID(t *testing.T) {
+	eventLog, cleanup := newTestEventLog(t)
+	defer cleanup()
+
+	for _, bootID := range []string{"boot1", "boot2", "boot3"} {
+		require.NoError(t, eventLog.Append(newTestEvent(t, xid.New(), bootID, &machine.SequenceEvent{Sequence: "boot"})))
+	}
+
+	openEventLog := func() (*eventlog.Log, error) {
+		return eventLog, nil
+	}
+
+	noEventLog := func() (*eventlog.Log, error) {
+		return nil, status.Error(codes.FailedPrecondition, "events persistence is not enabled")
+	}
+
+	for _, tt := range []struct {
+		name          string
+		req           *machine.EventsRequest
+		currentBootID string
+		openEventLog  func() (*eventlog.Log, error)
+
+		expected string
+		code     codes.Code
+	}{
+		{
+			name:          "current boot",
+			req:           &machine.EventsRequest{},
+			currentBootID: "boot3",
+			openEventLog:  noEventLog,
+			expected:      "boot3",
+		},
+		{
+			name:          "explicit boot ID",
+			req:           &machine.EventsRequest{BootId: "boot1"},
+			currentBootID: "boot3",
+			openEventLog:  noEventLog,
+			expected:      "boot1",
+		},
+		{
+			name:          "previous boot",
+			req:           &machine.EventsRequest{Boot: -1},
+			currentBootID: "boot3",
+			openEventLog:  openEventLog,
+			expected:      "boot2",
+		},
+		{
+			name:          "oldest boot",
+			req:           &machine.EventsRequest{Boot: -2},
+			currentBootID: "boot3",
+			openEventLog:  openEventLog,
+			expected:      "boot1",
+		},
+		{
+			name:          "boot before the log",
+			req:           &machine.EventsRequest{Boot: -3},
+			currentBootID: "boot3",
+			openEventLog:  openEventLog,
+			code:          codes.NotFound,
+		},
+		{
+			name:          "current boot not in the log yet",
+			req:           &machine.EventsRequest{Boot: -1},
+			currentBootID: "boot4",
+			openEventLog:  openEventLog,
+			expected:      "boot3",
+		},
+		{
+			name:          "persistence disabled",
+			req:           &machine.EventsRequest{Boot: -1},
+			currentBootID: "boot3",
+			openEventLog:  noEventLog,
+			code:          codes.FailedPrecondition,
+		},
+		{
+			name:          "boot ID and boot",
+			req:           &machine.EventsRequest{BootId: "boot1", Boot: -1},
+			currentBootID: "boot3",
+			openEventLog:  openEventLog,
+			code:          codes.InvalidArgument,
+		},
+		{
+			name:          "next boot",
+			req:           &machine.EventsRequest{Boot: 1},
+			currentBootID: "boot3",
+			openEventLog:  openEventLog,
+			code:          codes.InvalidArgument,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			bootID, err := resolveBootID(tt.req, tt.currentBootID, tt.openEventLog)
+
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, bootID)
+		})
+	}
+}
+
+func TestPersistedEvents(t *testing.T) {
+	eventLog, cleanup := newTestEventLog(t)
+	defer cleanup()
+
+	start := time.Date(2021, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	ids := make([]xid.ID, 6)
+
+	for i := range ids {
+		ids[i] = xid.NewWithTime(start.Add(time.Duration(i) * time.Minute))
+	}
+
+	for _, event := range []*machine.Event{
+		newTestEvent(t, ids[0], "boot1", &machine.SequenceEvent{Sequence: "boot"}),
+		newTestEvent(t, ids[1], "boot1", &machine.ServiceStateEvent{Service: "etcd"}),
+		newTestEvent(t, ids[2], "boot1", &machine.ServiceStateEvent{Service: "kubelet"}),
+		{Id: "malformed", BootId: "boot1"},
+		newTestEvent(t, ids[3], "boot1", &machine.ServiceStateEvent{Service: "etcd"}),
+		newTestEvent(t, ids[4], "boot1", &machine.SequenceEvent{Sequence: "shutdown"}),
+		newTestEvent(t, ids[5], "boot2", &machine.SequenceEvent{Sequence: "boot"}),
+	} {
+		require.NoError(t, eventLog.Append(event))
+	}
+
+	for _, tt := range []struct {
+		name     string
+		req      *machine.EventsRequest
+		expected []xid.ID
+	}{
+		{
+			name:     "all events of the boot",
+			req:      &machine.EventsRequest{},
+			expected: ids[:5],
+		},
+		{
+			name:     "since and until are inclusive",
+			req:      &machine.EventsRequest{Since: timestamppb.New(start.Add(time.Minute)), Until: timestamppb.New(start.Add(3 * time.Minute))},
+			expected: ids[1:4],
+		},
+		{
+			name:     "until between events",
+			req:      &machine.EventsRequest{Until: timestamppb.New(start.Add(90 * time.Second))},
+			expected: ids[:2],
+		},
+		{
+			name:     "tail ID",
+			req:      &machine.EventsRequest{TailId: ids[2].String()},
+			expected: ids[3:5],
+		},
+		{
+			name:     "tail events",
+			req:      &machine.EventsRequest{TailEvents: 2},
+			expected: ids[3:5],
+		},
+		{
+			name:     "filter by type",
+			req:      &machine.EventsRequest{Types: []string{"ServiceStateEvent"}},
+			expected: []xid.ID{ids[1], ids[2], ids[3]},
+		},
+		{
+			name:     "filter by type and name with tail",
+			req:      &machine.EventsRequest{Types: []string{"machine.ServiceStateEvent"}, Names: []string{"etcd"}, TailEvents: 1},
+			expected: []xid.ID{ids[3]},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []xid.ID
+
+			require.NoError(t, persistedEvents(tt.req, eventLog, "boot1", newEventFilter(tt.req), func(event *machine.Event) error {
+				id, err := xid.FromString(event.Id)
+				require.NoError(t, err)
+
+				sent = append(sent, id)
+
+				return nil
+			}))
+
+			assert.Equal(t, tt.expected, sent)
+		})
+	}
+
+	t.Run("send error", func(t *testing.T) {
+		sendErr := errors.New("stream closed")
+
+		assert.Equal(t, sendErr, persistedEvents(&machine.EventsRequest{}, eventLog, "boot1", newEventFilter(&machine.EventsRequest{}), func(*machine.Event) error {
+			return sendErr
+		}))
+	})
+
+	t.Run("bad tail ID", func(t *testing.T) {
+		assert.Error(t, persistedEvents(&machine.EventsRequest{TailId: "foo"}, eventLog, "boot1", newEventFilter(&machine.EventsRequest{}), func(*machine.Event) error {
+			return nil
+		}))
+	})
+}
+
+func TestEventFilter(t *testing.T) {
+	start := time.Date(2021, 2, 1, 10, 0, 0, 0, time.UTC)
+	id := xid.NewWithTime(start)
+
+	for _, tt := range []struct {
+		name    string
+		req     *machine.EventsRequest
+		id      xid.ID
+		payload proto.Message
+
+		expected bool
+	}{
+		{
+			name:     "no filter",
+			req:      &machine.EventsRequest{},
+			id:       id,
+			payload:  &machine.SequenceEvent{Sequence: "boot"},
+			expected: true,
+		},
+		{
+			name:     "type short name",
+			req:      &machine.EventsRequest{Types: []string{"SequenceEvent"}},
+			id:       id,
+			payload:  &machine.SequenceEvent{Sequence: "boot"},
+			expected: true,
+		},
+		{
+			name:     "type full name",
+			req:      &machine.EventsRequest{Types: []string{"machine.SequenceEvent"}},
+			id:       id,
+			payload:  &machine.SequenceEvent{Sequence: "boot"},
+			expected: true,
+		},
+		{
+			name:    "type mismatch",
+			req:     &machine.EventsRequest{Types: []string{"SequenceEvent", "PhaseEvent"}},
+			id:      id,
+			payload: &machine.TaskEvent{Task: "mountState"},
+		},
+		{
+			name:     "service name",
+			req:      &machine.EventsRequest{Names: []string{"etcd", "kubelet"}},
+			id:       id,
+			payload:  &machine.ServiceStateEvent{Service: "kubelet"},
+			expected: true,
+		},
+		{
+			name:    "service name mismatch",
+			req:     &machine.EventsRequest{Names: []string{"etcd"}},
+			id:      id,
+			payload: &machine.ServiceStateEvent{Service: "kubelet"},
+		},
+		{
+			name:     "service type and name",
+			req:      &machine.EventsRequest{Types: []string{"ServiceStateEvent"}, Names: []string{"etcd"}},
+			id:       id,
+			payload:  &machine.ServiceStateEvent{Service: "etcd"},
+			expected: true,
+		},
+		{
+			name:     "etcd node",
+			req:      &machine.EventsRequest{Names: []string{"master-1"}},
+			id:       id,
+			payload:  &machine.EtcdMemberEvent{Name: "master-1", Action: machine.EtcdMemberEvent_ADDED},
+			expected: true,
+		},
+		{
+			name:    "etcd node mismatch",
+			req:     &machine.EventsRequest{Names: []string{"master-1"}},
+			id:      id,
+			payload: &machine.EtcdMemberEvent{Name: "master-2", Action: machine.EtcdMemberEvent_REMOVED},
+		},
+		{
+			name:     "address link",
+			req:      &machine.EventsRequest{Names: []string{"eth0"}},
+			id:       id,
+			payload:  &machine.AddressEvent{Link: "eth0", Address: "10.5.0.2/24"},
+			expected: true,
+		},
+		{
+			name:    "name filter excludes events without name",
+			req:     &machine.EventsRequest{Names: []string{"eth0"}},
+			id:      id,
+			payload: &machine.TimeSyncEvent{Synced: true},
+		},
+		{
+			name:     "since is inclusive",
+			req:      &machine.EventsRequest{Since: timestamppb.New(start)},
+			id:       id,
+			payload:  &machine.SequenceEvent{Sequence: "boot"},
+			expected: true,
+		},
+		{
+			name:    "before since",
+			req:     &machine.EventsRequest{Since: timestamppb.New(start.Add(time.Second))},
+			id:      id,
+			payload: &machine.SequenceEvent{Sequence: "boot"},
+		},
+		{
+			name:     "until is inclusive",
+			req:      &machine.EventsRequest{Until: timestamppb.New(start)},
+			id:       id,
+			payload:  &machine.SequenceEvent{Sequence: "boot"},
+			expected: true,
+		},
+		{
+			name:    "after until",
+			req:     &machine.EventsRequest{Until: timestamppb.New(start.Add(-time.Second))},
+			id:      id,
+			payload: &machine.SequenceEvent{Sequence: "boot"},
+		},
+		{
+			name:     "time and type",
+			req:      &machine.EventsRequest{Since: timestamppb.New(start.Add(-time.Minute)), Until: timestamppb.New(start.Add(time.Minute)), Types: []string{"AddressEvent"}},
+			id:       id,
+			payload:  &machine.AddressEvent{Link: "eth0"},
+			expected: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := newEventFilter(tt.req)
+
+			assert.Equal(t, tt.expected, filter.Matches(tt.id, tt.payload))
+
+			// serialized event is filtered the same way
+			assert.Equal(t, tt.expected, filter.MatchesEvent(newTestEvent(t, tt.id, "boot1", tt.payload)))
+		})
+	}
+
+	t.Run("malformed event", func(t *testing.T) {
+		assert.False(t, newEventFilter(&machine.EventsRequest{}).MatchesEvent(&machine.Event{Id: "malformed"}))
+
+		filter := newEventFilter(&machine.EventsRequest{Types: []string{"SequenceEvent"}})
+
+		assert.False(t, filter.MatchesEvent(&machine.Event{
+			Id:   id.String(),
+			Data: &any.Any{TypeUrl: "talos/runtime/machine.UnknownEvent"},
+		}))
+	})
+}
@@ -0,0 +1,354 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/docker/distribution/reference"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/internal/pkg/cri"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// imagePullProgressInterval is the interval between pull progress updates.
+const imagePullProgressInterval = time.Second
+
+// ImageList implements the machine.MachineServer interface.
+//
+// Images in the "k8s.io" namespace are listed via CRI, images in the "system" namespace
+// are listed via containerd.
+func (s *Server) ImageList(in *machine.ImageListRequest, srv machine.MachineService_ImageListServer) error {
+	namespace, err := imageNamespace(in.Namespace)
+	if err != nil {
+		return err
+	}
+
+	ctx := srv.Context()
+
+	if namespace == criconstants.K8sContainerdNamespace {
+		client, err := cri.NewClient("unix:"+constants.ContainerdAddress, 10*time.Second)
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer client.Close()
+
+		imgs, err := client.ListImages(ctx, &runtimeapi.ImageFilter{})
+		if err != nil {
+			return err
+		}
+
+		for _, img := range imgs {
+			names := img.RepoTags
+			if len(names) == 0 {
+				names = img.RepoDigests
+			}
+
+			for _, name := range names {
+				if err = srv.Send(&machine.ImageListResponse{
+					Namespace: namespace,
+					Name:      name,
+					Digest:    img.Id,
+					Size:      int64(img.Size_),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer client.Close()
+
+	ctx = namespaces.WithNamespace(ctx, namespace)
+
+	imgs, err := client.ImageService().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, img := range imgs {
+		size, err := containerd.NewImage(client, img).Size(ctx)
+		if err != nil {
+			// image content might be partially missing
+			size = 0
+		}
+
+		if err = srv.Send(&machine.ImageListResponse{
+			Namespace: namespace,
+			Name:      img.Name,
+			Digest:    img.Target.Digest.String(),
+			Size:      size,
+			CreatedAt: timestamppb.New(img.CreatedAt),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImagePull implements the machine.MachineServer interface.
+//
+// Progress of the downloads which belong to the pull is streamed while the image is being pulled,
+// the last message contains the pulled image reference.
+//
+//nolint: gocyclo
+func (s *Server) ImagePull(in *machine.ImagePullRequest, srv machine.MachineService_ImagePullServer) error {
+	namespace, err := imageNamespace(in.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if in.Reference == "" {
+		return status.Error(codes.InvalidArgument, "image reference should be specified")
+	}
+
+	addr := constants.ContainerdAddress
+	if namespace == constants.SystemContainerdNamespace {
+		addr = constants.SystemContainerdAddress
+	}
+
+	client, err := containerd.New(addr)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer client.Close()
+
+	ctx := namespaces.WithNamespace(srv.Context(), namespace)
+	registries := s.Controller.Runtime().Config().Machine().Registries()
+
+	// content refs of the pull are resolved separately, as other pulls might be running at the same time
+	refsCh := make(chan map[string]struct{}, 1)
+
+	go func() {
+		refs, err := image.ContentRefs(ctx, image.NewResolver(registries), normalizeImageName(in.Reference), platforms.Default())
+		if err != nil {
+			// progress is informational only
+			return
+		}
+
+		refsCh <- refs
+	}()
+
+	type pullResult struct {
+		imageRef string
+		err      error
+	}
+
+	resultCh := make(chan pullResult, 1)
+
+	go func() {
+		var res pullResult
+
+		if namespace == criconstants.K8sContainerdNamespace {
+			// pull via CRI, so that the image is available to the kubelet
+			var criClient *cri.Client
+
+			criClient, res.err = cri.NewClient("unix:"+constants.ContainerdAddress, 10*time.Second)
+			if res.err == nil {
+				res.imageRef, res.err = criClient.PullImage(ctx, &runtimeapi.ImageSpec{Image: in.Reference}, nil)
+
+				criClient.Close() //nolint: errcheck
+			}
+		} else {
+			var img containerd.Image
+
+			img, res.err = image.Pull(ctx, registries, client, in.Reference)
+			if res.err == nil {
+				res.imageRef = img.Name() + "@" + img.Target().Digest.String()
+			}
+		}
+
+		resultCh <- res
+	}()
+
+	ticker := time.NewTicker(imagePullProgressInterval)
+	defer ticker.Stop()
+
+	var refs map[string]struct{}
+
+	for {
+		select {
+		case res := <-resultCh:
+			if res.err != nil {
+				return res.err
+			}
+
+			return srv.Send(&machine.ImagePullResponse{
+				ImageRef: res.imageRef,
+			})
+		case refs = <-refsCh:
+		case <-ticker.C:
+			if refs == nil {
+				continue
+			}
+
+			statuses, err := client.ContentStore().ListStatuses(ctx)
+			if err != nil {
+				// progress is informational only
+				continue
+			}
+
+			progress := pullProgress(statuses, refs)
+			if len(progress) == 0 {
+				continue
+			}
+
+			if err = srv.Send(&machine.ImagePullResponse{
+				Progress: progress,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ImageRemove implements the machine.MachineServer interface.
+//
+// Images in the "system" namespace and the images Talos runs itself (installer, etcd, kubelet)
+// can't be removed.
+func (s *Server) ImageRemove(ctx context.Context, in *machine.ImageRemoveRequest) (*machine.ImageRemoveResponse, error) {
+	namespace, err := imageNamespace(in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Reference == "" {
+		return nil, status.Error(codes.InvalidArgument, "image reference should be specified")
+	}
+
+	if namespace == constants.SystemContainerdNamespace {
+		return nil, status.Errorf(codes.FailedPrecondition, "images in the %q namespace are managed by Talos and can't be removed", namespace)
+	}
+
+	client, err := cri.NewClient("unix:"+constants.ContainerdAddress, 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer client.Close()
+
+	names := []string{in.Reference}
+
+	// resolve the reference, so that the image can't be removed by its ID or digest
+	img, err := client.ImageStatus(ctx, &runtimeapi.ImageSpec{Image: in.Reference})
+	if err != nil {
+		return nil, err
+	}
+
+	if img != nil {
+		names = append(names, img.RepoTags...)
+		names = append(names, img.RepoDigests...)
+	}
+
+	if name, ok := protectedImage(s.Controller.Runtime().Config(), names); ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "image %q is used by Talos and can't be removed", name)
+	}
+
+	if err = client.RemoveImage(ctx, &runtimeapi.ImageSpec{Image: in.Reference}); err != nil {
+		return nil, err
+	}
+
+	return &machine.ImageRemoveResponse{
+		Messages: []*machine.ImageRemove{
+			{},
+		},
+	}, nil
+}
+
+// imageNamespace validates containerd namespace for the image APIs.
+func imageNamespace(namespace string) (string, error) {
+	switch namespace {
+	case constants.SystemContainerdNamespace, criconstants.K8sContainerdNamespace:
+		return namespace, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported namespace %q, expected %q or %q",
+			namespace, constants.SystemContainerdNamespace, criconstants.K8sContainerdNamespace)
+	}
+}
+
+// protectedImage returns the first of the image names which is used by Talos itself.
+func protectedImage(cfg config.Provider, names []string) (string, bool) {
+	refs := []string{
+		cfg.Machine().Install().Image(),
+		cfg.Machine().Kubelet().Image(),
+	}
+
+	if cfg.Machine().Type() != machinetype.TypeJoin {
+		refs = append(refs, cfg.Cluster().Etcd().Image())
+	}
+
+	protected := map[string]struct{}{}
+
+	for _, ref := range refs {
+		if ref != "" {
+			protected[normalizeImageName(ref)] = struct{}{}
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := protected[normalizeImageName(name)]; ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeImageName converts image reference to the fully qualified form, e.g. "busybox" to "docker.io/library/busybox:latest".
+func normalizeImageName(ref string) string {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return ref
+	}
+
+	return reference.TagNameOnly(named).String()
+}
+
+// pullProgress converts content store statuses of the downloads which belong to the pull to the pull progress.
+func pullProgress(statuses []content.Status, refs map[string]struct{}) []*machine.ImagePullProgress {
+	var progress []*machine.ImagePullProgress
+
+	for _, st := range statuses {
+		if _, ok := refs[st.Ref]; !ok {
+			continue
+		}
+
+		progress = append(progress, &machine.ImagePullProgress{
+			Ref:    st.Ref,
+			Offset: st.Offset,
+			Total:  st.Total,
+		})
+	}
+
+	return progress
+}
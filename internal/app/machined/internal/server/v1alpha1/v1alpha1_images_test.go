@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestProtectedImage(t *testing.T) {
+	newConfig := func(machineType string) *v1alpha1.Config {
+		return &v1alpha1.Config{
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineType: machineType,
+				MachineInstall: &v1alpha1.InstallConfig{
+					InstallImage: "ghcr.io/talos-systems/installer:v0.9.0",
+				},
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletImage: "docker.io/acme/kubelet:v1.20.1",
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				EtcdConfig: &v1alpha1.EtcdConfig{
+					ContainerImage: "gcr.io/etcd-development/etcd:v3.4.14",
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		machineType string
+		names       []string
+		expected    string
+	}{
+		{
+			name:        "unrelated",
+			machineType: "controlplane",
+			names:       []string{"busybox", "docker.io/library/nginx:latest"},
+		},
+		{
+			name:        "installer",
+			machineType: "join",
+			names:       []string{"ghcr.io/talos-systems/installer:v0.9.0"},
+			expected:    "ghcr.io/talos-systems/installer:v0.9.0",
+		},
+		{
+			name:        "kubelet short name",
+			machineType: "join",
+			names:       []string{"acme/kubelet:v1.20.1"},
+			expected:    "acme/kubelet:v1.20.1",
+		},
+		{
+			name:        "resolved by ID",
+			machineType: "join",
+			names:       []string{"sha256:0123456789abcdef", "docker.io/acme/kubelet:v1.20.1"},
+			expected:    "docker.io/acme/kubelet:v1.20.1",
+		},
+		{
+			name:        "other tag",
+			machineType: "join",
+			names:       []string{"ghcr.io/talos-systems/installer:v0.8.0"},
+		},
+		{
+			name:        "etcd on control plane",
+			machineType: "controlplane",
+			names:       []string{"gcr.io/etcd-development/etcd:v3.4.14"},
+			expected:    "gcr.io/etcd-development/etcd:v3.4.14",
+		},
+		{
+			name:        "etcd on worker",
+			machineType: "join",
+			names:       []string{"gcr.io/etcd-development/etcd:v3.4.14"},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := protectedImage(newConfig(tt.machineType), tt.names)
+
+			assert.Equal(t, tt.expected != "", ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestPullProgress(t *testing.T) {
+	statuses := []content.Status{
+		{Ref: "layer-sha256:1", Offset: 10, Total: 100},
+		{Ref: "layer-sha256:2", Offset: 20, Total: 200},
+		{Ref: "manifest-sha256:3", Offset: 30, Total: 300},
+	}
+
+	assert.Empty(t, pullProgress(statuses, map[string]struct{}{}))
+
+	assert.Equal(t, []*machine.ImagePullProgress{
+		{Ref: "layer-sha256:1", Offset: 10, Total: 100},
+		{Ref: "manifest-sha256:3", Offset: 30, Total: 300},
+	}, pullProgress(statuses, map[string]struct{}{
+		"layer-sha256:1":    {},
+		"manifest-sha256:3": {},
+		"config-sha256:4":   {},
+	}))
+}
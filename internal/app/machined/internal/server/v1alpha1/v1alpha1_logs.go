@@ -0,0 +1,292 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// logQueryChunkSize is the size of the chunks log query history is sent in.
+const logQueryChunkSize = 32 * 1024
+
+// logLine is a single line returned by the log query.
+type logLine struct {
+	id   string
+	ts   time.Time
+	line string
+}
+
+// isLogQuery returns true if the request requires reading the logs line by line.
+func isLogQuery(req *machine.LogsRequest) bool {
+	return len(req.Ids) > 0 || req.Since != nil || req.Until != nil || req.Grep != ""
+}
+
+// queryLogs reads the logs of one or more services filtering them by time and
+// contents of the line.
+//
+// Logs of several services are interleaved by the time they were written at,
+// and each line is prefixed with the service ID.
+//
+//nolint: gocyclo
+func (s *Server) queryLogs(req *machine.LogsRequest, l machine.MachineService_LogsServer) error {
+	ids := append([]string{req.Id}, req.Ids...)
+
+	for _, id := range ids {
+		if req.Namespace != constants.SystemContainerdNamespace && id != "kubelet" {
+			return status.Errorf(codes.InvalidArgument, "log queries are supported only for system services, %q is not supported", id)
+		}
+	}
+
+	match, err := logLineMatcher(req.Grep, req.GrepRegex)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var since, until time.Time
+
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
+	if req.Until != nil {
+		until = req.Until.AsTime()
+	}
+
+	var (
+		lines   []logLine
+		cursors = make([]time.Time, len(ids))
+	)
+
+	for i, id := range ids {
+		var serviceLines []logLine
+
+		// the last TailLines lines of the query are the last lines of some services, so only those are kept
+		serviceLines, cursors[i], err = s.readServiceLog(id, match, int(req.TailLines), runtime.WithLogSince(since), runtime.WithLogUntil(until))
+		if err != nil {
+			return err
+		}
+
+		lines = append(lines, serviceLines...)
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].ts.Before(lines[j].ts) })
+
+	if req.TailLines >= 0 && int(req.TailLines) < len(lines) {
+		lines = lines[len(lines)-int(req.TailLines):]
+	}
+
+	prefix := len(ids) > 1
+
+	var buf bytes.Buffer
+
+	for _, line := range lines {
+		buf.WriteString(formatLogLine(line, prefix))
+
+		if buf.Len() >= logQueryChunkSize {
+			if err = l.Send(&common.Data{Bytes: buf.Bytes()}); err != nil {
+				return err
+			}
+
+			buf = bytes.Buffer{}
+		}
+	}
+
+	if buf.Len() > 0 {
+		if err = l.Send(&common.Data{Bytes: buf.Bytes()}); err != nil {
+			return err
+		}
+	}
+
+	if !req.Follow || !until.IsZero() {
+		return nil
+	}
+
+	return s.followLogs(ids, cursors, since, match, prefix, l)
+}
+
+// followLogs streams the log lines written after the cursors.
+func (s *Server) followLogs(ids []string, cursors []time.Time, since time.Time, match func(string) bool, prefix bool, l machine.MachineService_LogsServer) error {
+	ctx := l.Context()
+
+	linesCh := make(chan logLine)
+	errCh := make(chan error, len(ids))
+
+	for i, id := range ids {
+		cursor := since
+
+		if !cursors[i].IsZero() {
+			cursor = cursors[i].Add(time.Nanosecond)
+		}
+
+		r, err := s.Controller.Runtime().Logging().ServiceLog(id).Reader(runtime.WithFollow(), runtime.WithTimestamps(), runtime.WithLogSince(cursor))
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer r.Close()
+
+		go func(id string, r io.Reader) {
+			errCh <- scanLogLines(id, r, func(line logLine) bool {
+				if !match(line.line) {
+					return true
+				}
+
+				select {
+				case linesCh <- line:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+		}(id, r)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			if err != nil {
+				return err
+			}
+		case line := <-linesCh:
+			if err := l.Send(&common.Data{Bytes: []byte(formatLogLine(line, prefix))}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readServiceLog reads the last tailLines matching lines of the service log (all the lines if tailLines is negative).
+//
+// The time of the last line read is returned as the cursor to follow the log from.
+func (s *Server) readServiceLog(id string, match func(string) bool, tailLines int, opts ...runtime.LogOption) ([]logLine, time.Time, error) {
+	r, err := s.Controller.Runtime().Logging().ServiceLog(id).Reader(append(opts, runtime.WithTimestamps())...)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	//nolint: errcheck
+	defer r.Close()
+
+	var cursor time.Time
+
+	lines := newLogLineRing(tailLines)
+
+	err = scanLogLines(id, r, func(line logLine) bool {
+		cursor = line.ts
+
+		if match(line.line) {
+			lines.push(line)
+		}
+
+		return true
+	})
+
+	return lines.get(), cursor, err
+}
+
+// logLineRing keeps the last lines pushed to it.
+type logLineRing struct {
+	limit int
+	lines []logLine
+	next  int
+}
+
+// newLogLineRing creates the ring which keeps up to limit lines, negative limit keeps all the lines.
+func newLogLineRing(limit int) *logLineRing {
+	return &logLineRing{
+		limit: limit,
+	}
+}
+
+func (ring *logLineRing) push(line logLine) {
+	switch {
+	case ring.limit < 0 || len(ring.lines) < ring.limit:
+		ring.lines = append(ring.lines, line)
+	case ring.limit > 0:
+		ring.lines[ring.next] = line
+		ring.next = (ring.next + 1) % ring.limit
+	}
+}
+
+// get returns the lines in the order they were pushed.
+func (ring *logLineRing) get() []logLine {
+	return append(append([]logLine(nil), ring.lines[ring.next:]...), ring.lines[:ring.next]...)
+}
+
+// scanLogLines parses the log lines prefixed with timestamps until EOF or until fn returns false.
+func scanLogLines(id string, r io.Reader, fn func(logLine) bool) error {
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadString('\n')
+
+		if line != "" {
+			parts := strings.SplitN(line, " ", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("error parsing log line %q", line)
+			}
+
+			ts, parseErr := time.Parse(runtime.LogTimestampLayout, parts[0])
+			if parseErr != nil {
+				return fmt.Errorf("error parsing log line timestamp: %w", parseErr)
+			}
+
+			if !fn(logLine{id: id, ts: ts, line: strings.TrimSuffix(parts[1], "\n")}) {
+				return nil
+			}
+		}
+
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+// logLineMatcher builds the line filter for substring or regular expression grep.
+func logLineMatcher(grep string, isRegex bool) (func(string) bool, error) {
+	switch {
+	case grep == "":
+		return func(string) bool { return true }, nil
+	case isRegex:
+		re, err := regexp.Compile(grep)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling grep expression: %w", err)
+		}
+
+		return re.MatchString, nil
+	default:
+		return func(line string) bool { return strings.Contains(line, grep) }, nil
+	}
+}
+
+func formatLogLine(line logLine, prefix bool) string {
+	if prefix {
+		return line.id + ": " + line.line + "\n"
+	}
+
+	return line.line + "\n"
+}
@@ -0,0 +1,301 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+type mockRuntime struct {
+	runtime.Runtime
+
+	logging runtime.LoggingManager
+}
+
+func (r *mockRuntime) Logging() runtime.LoggingManager {
+	return r.logging
+}
+
+type mockController struct {
+	runtime.Controller
+
+	runtime *mockRuntime
+}
+
+func (c *mockController) Runtime() runtime.Runtime {
+	return c.runtime
+}
+
+type mockLogsServer struct {
+	grpc.ServerStream
+
+	data []byte
+}
+
+func (l *mockLogsServer) Context() context.Context {
+	return context.Background()
+}
+
+func (l *mockLogsServer) Send(data *common.Data) error {
+	l.data = append(l.data, data.Bytes...)
+
+	return nil
+}
+
+var logsBase = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newTestLogsServer writes the service logs stamped with logsBase plus the number of seconds.
+func newTestLogsServer(t *testing.T, logs map[string]map[int]string) (*Server, func()) {
+	dir, err := ioutil.TempDir("", "logs")
+	require.NoError(t, err)
+
+	for id, lines := range logs {
+		var contents strings.Builder
+
+		for i := 0; i < 10; i++ {
+			if line, ok := lines[i]; ok {
+				contents.WriteString(logsBase.Add(time.Duration(i)*time.Second).Format(runtime.LogTimestampLayout) + " " + line + "\n")
+			}
+		}
+
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, id+".log"), []byte(contents.String()), 0o644))
+	}
+
+	s := &Server{
+		Controller: &mockController{
+			runtime: &mockRuntime{
+				logging: logging.NewFileLoggingManager(dir, logging.WithLineTimestamps()),
+			},
+		},
+	}
+
+	return s, func() { os.RemoveAll(dir) } //nolint: errcheck
+}
+
+func TestQueryLogs(t *testing.T) {
+	s, cleanup := newTestLogsServer(t, map[string]map[int]string{
+		"apid": {
+			0: "apid started",
+			2: "apid request",
+			4: "apid error: connection refused",
+		},
+		"trustd": {
+			1: "trustd started",
+			3: "trustd error: certificate expired",
+			5: "trustd request",
+		},
+	})
+	defer cleanup()
+
+	at := func(seconds int) *timestamppb.Timestamp {
+		return timestamppb.New(logsBase.Add(time.Duration(seconds) * time.Second))
+	}
+
+	for _, tt := range []struct {
+		name string
+		req  *machine.LogsRequest
+
+		expected string
+		code     codes.Code
+	}{
+		{
+			name: "single service",
+			req:  &machine.LogsRequest{Id: "apid", TailLines: -1, Grep: "request"},
+
+			expected: "apid request\n",
+		},
+		{
+			name: "interleaved",
+			req:  &machine.LogsRequest{Id: "trustd", Ids: []string{"apid"}, TailLines: -1},
+
+			expected: "apid: apid started\ntrustd: trustd started\napid: apid request\n" +
+				"trustd: trustd error: certificate expired\napid: apid error: connection refused\ntrustd: trustd request\n",
+		},
+		{
+			name: "tail",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: 2},
+
+			expected: "apid: apid error: connection refused\ntrustd: trustd request\n",
+		},
+		{
+			name: "tail with grep",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: 1, Grep: "started"},
+
+			expected: "trustd: trustd started\n",
+		},
+		{
+			name: "zero tail",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: 0},
+		},
+		{
+			name: "since and until are inclusive",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: -1, Since: at(2), Until: at(3)},
+
+			expected: "apid: apid request\ntrustd: trustd error: certificate expired\n",
+		},
+		{
+			name: "since",
+			req:  &machine.LogsRequest{Id: "apid", TailLines: -1, Since: at(3)},
+
+			expected: "apid error: connection refused\n",
+		},
+		{
+			name: "until",
+			req:  &machine.LogsRequest{Id: "trustd", TailLines: -1, Until: at(0)},
+		},
+		{
+			name: "grep",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: -1, Grep: "error"},
+
+			expected: "trustd: trustd error: certificate expired\napid: apid error: connection refused\n",
+		},
+		{
+			name: "grep is not a regex",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: -1, Grep: "error.*expired"},
+		},
+		{
+			name: "regex grep",
+			req:  &machine.LogsRequest{Id: "apid", Ids: []string{"trustd"}, TailLines: -1, Grep: "error.*expired", GrepRegex: true},
+
+			expected: "trustd: trustd error: certificate expired\n",
+		},
+		{
+			name: "bad regex",
+			req:  &machine.LogsRequest{Id: "apid", TailLines: -1, Grep: "error(", GrepRegex: true},
+
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "not a system service",
+			req:  &machine.LogsRequest{Namespace: constants.K8sContainerdNamespace, Id: "apid", Ids: []string{"etcd"}, TailLines: -1},
+
+			code: codes.InvalidArgument,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.req.Namespace == "" {
+				tt.req.Namespace = constants.SystemContainerdNamespace
+			}
+
+			var l mockLogsServer
+
+			err := s.queryLogs(tt.req, &l)
+
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(l.data))
+		})
+	}
+}
+
+func TestScanLogLines(t *testing.T) {
+	var lines []logLine
+
+	require.NoError(t, scanLogLines("apid", strings.NewReader("2021-01-01T00:00:01Z first line\n2021-01-01T00:00:02.5Z second line"), func(line logLine) bool {
+		lines = append(lines, line)
+
+		return true
+	}))
+
+	assert.Equal(t, []logLine{
+		{id: "apid", ts: logsBase.Add(time.Second), line: "first line"},
+		{id: "apid", ts: logsBase.Add(2500 * time.Millisecond), line: "second line"},
+	}, lines)
+
+	// stops when fn returns false
+	lines = nil
+
+	require.NoError(t, scanLogLines("apid", strings.NewReader("2021-01-01T00:00:01Z first line\n2021-01-01T00:00:02Z second line\n"), func(line logLine) bool {
+		lines = append(lines, line)
+
+		return false
+	}))
+
+	assert.Len(t, lines, 1)
+
+	assert.Error(t, scanLogLines("apid", strings.NewReader("nospace\n"), func(logLine) bool { return true }))
+	assert.Error(t, scanLogLines("apid", strings.NewReader("yesterday line\n"), func(logLine) bool { return true }))
+}
+
+func TestLogLineRing(t *testing.T) {
+	line := func(i int) logLine {
+		return logLine{id: "apid", ts: logsBase.Add(time.Duration(i) * time.Second)}
+	}
+
+	for _, tt := range []struct {
+		limit    int
+		pushed   int
+		expected []logLine
+	}{
+		{limit: -1, pushed: 3, expected: []logLine{line(0), line(1), line(2)}},
+		{limit: 0, pushed: 3},
+		{limit: 2, pushed: 1, expected: []logLine{line(0)}},
+		{limit: 2, pushed: 2, expected: []logLine{line(0), line(1)}},
+		{limit: 2, pushed: 5, expected: []logLine{line(3), line(4)}},
+		{limit: 3, pushed: 7, expected: []logLine{line(4), line(5), line(6)}},
+	} {
+		ring := newLogLineRing(tt.limit)
+
+		for i := 0; i < tt.pushed; i++ {
+			ring.push(line(i))
+		}
+
+		assert.Equal(t, tt.expected, ring.get(), "limit %d, pushed %d", tt.limit, tt.pushed)
+
+		if tt.limit >= 0 {
+			assert.LessOrEqual(t, len(ring.lines), tt.limit)
+		}
+	}
+}
+
+func TestLogLineMatcher(t *testing.T) {
+	match, err := logLineMatcher("", false)
+	require.NoError(t, err)
+	assert.True(t, match("anything"))
+
+	match, err = logLineMatcher("err", false)
+	require.NoError(t, err)
+	assert.True(t, match("some error"))
+	assert.False(t, match("all good"))
+
+	match, err = logLineMatcher("e.r", false)
+	require.NoError(t, err)
+	assert.False(t, match("some error"))
+	assert.True(t, match("e.r"))
+
+	match, err = logLineMatcher("^some (error|warning)$", true)
+	require.NoError(t, err)
+	assert.True(t, match("some warning"))
+	assert.False(t, match("some error!"))
+
+	_, err = logLineMatcher("(", true)
+	assert.EqualError(t, err, "error compiling grep expression: error parsing regexp: missing closing ): `(`")
+}
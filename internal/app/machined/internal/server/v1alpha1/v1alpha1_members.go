@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Node roles reported in the cluster members.
+const (
+	clusterMemberRoleControlPlane = "controlplane"
+	clusterMemberRoleWorker       = "worker"
+)
+
+// ClusterMembers implements the machine.MachineServer interface.
+//
+// Members are the Kubernetes nodes of the cluster, they are listed with the kubelet credentials,
+// so that apid can resolve node selectors without having access to the kubelet credentials.
+func (s *Server) ClusterMembers(ctx context.Context, in *empty.Empty) (*machine.ClusterMembersResponse, error) {
+	client, err := kubernetes.NewClientFromKubeletKubeconfig()
+	if err != nil {
+		return nil, fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Kubernetes nodes: %w", err)
+	}
+
+	members := make([]*machine.ClusterMember, 0, len(nodes.Items))
+
+	for _, node := range nodes.Items {
+		if member := clusterMember(&node); member != nil {
+			members = append(members, member)
+		}
+	}
+
+	return &machine.ClusterMembersResponse{
+		Messages: []*machine.ClusterMembers{
+			{
+				Members: members,
+			},
+		},
+	}, nil
+}
+
+// clusterMember converts Kubernetes node to the cluster member.
+//
+// Nodes without internal IP address can't be reached, so nil is returned for them.
+func clusterMember(node *corev1.Node) *machine.ClusterMember {
+	role := clusterMemberRoleWorker
+
+	if _, ok := node.Labels[constants.LabelNodeRoleMaster]; ok {
+		role = clusterMemberRoleControlPlane
+	}
+
+	for _, nodeAddress := range node.Status.Addresses {
+		if nodeAddress.Type == corev1.NodeInternalIP {
+			return &machine.ClusterMember{
+				Hostname: node.Name,
+				Address:  nodeAddress.Address,
+				Role:     role,
+			}
+		}
+	}
+
+	return nil
+}
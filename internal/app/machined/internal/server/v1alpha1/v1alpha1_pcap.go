@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+
+	"golang.org/x/net/bpf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/pcap"
+	"github.com/talos-systems/talos/internal/pkg/pcap/filter"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// PacketCapture implements the machine.MachineServer interface.
+//
+// Captured packets are streamed in pcap-ng format, one block per message.
+// Capture is stopped after constants.MaxPacketCaptureDuration even if the request doesn't limit the duration.
+func (s *Server) PacketCapture(in *machine.PacketCaptureRequest, srv machine.MachineService_PacketCaptureServer) error {
+	if in.Interface == "" {
+		return status.Error(codes.InvalidArgument, "interface should be specified")
+	}
+
+	if in.SnapLen > constants.MaxPacketCaptureSnapLen {
+		return status.Errorf(codes.InvalidArgument, "snap length should be at most %d", constants.MaxPacketCaptureSnapLen)
+	}
+
+	duration := in.GetDuration().AsDuration()
+
+	switch {
+	case duration < 0:
+		return status.Error(codes.InvalidArgument, "duration should be positive")
+	case duration > constants.MaxPacketCaptureDuration:
+		return status.Errorf(codes.InvalidArgument, "duration should be at most %s", constants.MaxPacketCaptureDuration)
+	case duration == 0:
+		duration = constants.MaxPacketCaptureDuration
+	}
+
+	ctx, cancel := context.WithTimeout(srv.Context(), duration)
+	defer cancel()
+
+	program, err := packetCaptureFilter(in)
+	if err != nil {
+		return err
+	}
+
+	return pcap.Capture(ctx, pcap.Options{
+		Interface:   in.Interface,
+		Promiscuous: in.Promiscuous,
+		SnapLen:     in.SnapLen,
+		Filter:      program,
+	}, &packetCaptureWriter{srv: srv})
+}
+
+// packetCaptureFilter returns BPF program either passed in the request or compiled
+// from the filter expression for the link type of the interface.
+func packetCaptureFilter(in *machine.PacketCaptureRequest) ([]bpf.RawInstruction, error) {
+	if in.Filter != "" {
+		if len(in.BpfFilter) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "filter and bpf_filter can't be used together")
+		}
+
+		linkType, err := pcap.InterfaceLinkType(in.Interface)
+		if err != nil {
+			return nil, err
+		}
+
+		link := filter.LinkEthernet
+		if linkType == pcap.LinkTypeRaw {
+			link = filter.LinkRaw
+		}
+
+		snapLen := in.SnapLen
+		if snapLen == 0 {
+			snapLen = constants.DefaultPacketCaptureSnapLen
+		}
+
+		program, err := filter.Compile(in.Filter, link, snapLen)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error compiling filter: %s", err)
+		}
+
+		return program, nil
+	}
+
+	program := make([]bpf.RawInstruction, len(in.BpfFilter))
+
+	for i, ins := range in.BpfFilter {
+		if ins.Op > 0xffff || ins.Jt > 0xff || ins.Jf > 0xff {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid BPF instruction %d", i)
+		}
+
+		program[i] = bpf.RawInstruction{
+			Op: uint16(ins.Op),
+			Jt: uint8(ins.Jt),
+			Jf: uint8(ins.Jf),
+			K:  ins.K,
+		}
+	}
+
+	return program, nil
+}
+
+// packetCaptureWriter sends every write as a separate message.
+type packetCaptureWriter struct {
+	srv machine.MachineService_PacketCaptureServer
+}
+
+func (w *packetCaptureWriter) Write(p []byte) (int, error) {
+	if err := w.srv.Send(&common.Data{Bytes: p}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
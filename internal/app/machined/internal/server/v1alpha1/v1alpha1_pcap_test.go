@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func TestPacketCaptureValidation(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		req  *machine.PacketCaptureRequest
+
+		expected string
+	}{
+		{
+			name: "no interface",
+			req:  &machine.PacketCaptureRequest{},
+
+			expected: "interface should be specified",
+		},
+		{
+			name: "snap length over the limit",
+			req: &machine.PacketCaptureRequest{
+				Interface: "eth0",
+				SnapLen:   constants.MaxPacketCaptureSnapLen + 1,
+			},
+
+			expected: "snap length should be at most 262144",
+		},
+		{
+			name: "huge snap length",
+			req: &machine.PacketCaptureRequest{
+				Interface: "eth0",
+				SnapLen:   1<<32 - 1,
+			},
+
+			expected: "snap length should be at most 262144",
+		},
+		{
+			name: "negative duration",
+			req: &machine.PacketCaptureRequest{
+				Interface: "eth0",
+				Duration:  durationpb.New(-time.Second),
+			},
+
+			expected: "duration should be positive",
+		},
+		{
+			name: "duration over the limit",
+			req: &machine.PacketCaptureRequest{
+				Interface: "eth0",
+				SnapLen:   constants.MaxPacketCaptureSnapLen,
+				Duration:  durationpb.New(time.Hour),
+			},
+
+			expected: "duration should be at most 30m0s",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			// requests are rejected before the capture is started, so the stream is not used
+			err := (&Server{}).PacketCapture(tt.req, nil)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Equal(t, tt.expected, status.Convert(err).Message())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -35,6 +36,10 @@ import (
 	"go.etcd.io/etcd/clientv3/concurrency"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/internal/install"
@@ -43,20 +48,24 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/clientcert"
 	"github.com/talos-systems/talos/internal/pkg/configuration"
 	"github.com/talos-systems/talos/internal/pkg/containers"
 	taloscontainerd "github.com/talos-systems/talos/internal/pkg/containers/containerd"
 	"github.com/talos-systems/talos/internal/pkg/containers/cri"
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/eventlog"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/chunker"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/identity"
 	"github.com/talos-systems/talos/pkg/machinery/api/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	healthapi "github.com/talos-systems/talos/pkg/machinery/api/health"
 	"github.com/talos-systems/talos/pkg/machinery/api/inspect"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/api/resource"
@@ -100,6 +109,7 @@ func (s *Server) Register(obj *grpc.Server) {
 	cluster.RegisterClusterServiceServer(obj, s)
 	resource.RegisterResourceServiceServer(obj, &ResourceServer{server: s})
 	inspect.RegisterInspectServiceServer(obj, &InspectServer{server: s})
+	healthapi.RegisterNodeHealthServiceServer(obj, s)
 }
 
 // ApplyConfiguration implements machine.MachineService.
@@ -141,6 +151,11 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		}
 	}
 
+	s.Controller.Runtime().Events().Publish(&machine.ConfigEvent{
+		Hash:     fmt.Sprintf("%x", sha256.Sum256(in.GetData())),
+		NoReboot: in.NoReboot,
+	})
+
 	reply = &machine.ApplyConfigurationResponse{
 		Messages: []*machine.ApplyConfiguration{
 			{},
@@ -156,6 +171,48 @@ func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.Generate
 	return configuration.Generate(ctx, in)
 }
 
+// GenerateClientCertificate implements the machine.MachineServer interface.
+//
+// Certificate is issued by the Talos API CA for the public key of the CSR submitted by an authenticated client,
+// so that the client can renew its certificate before it expires. Subject and roles of the new certificate
+// are taken from the client certificate verified by apid.
+func (s *Server) GenerateClientCertificate(ctx context.Context, in *machine.GenerateClientCertificateRequest) (reply *machine.GenerateClientCertificateResponse, err error) {
+	ca := s.Controller.Runtime().Config().Machine().Security().CA()
+
+	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeJoin || ca == nil || len(ca.Key) == 0 {
+		return nil, fmt.Errorf("client certificates can only be issued by a control plane node")
+	}
+
+	id, ok := identity.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "client identity is not known, the request should be sent directly to the control plane node")
+	}
+
+	ttl := constants.TalosAdminCertDefaultLifetime
+
+	if in.GetTtl() != nil {
+		ttl = in.GetTtl().AsDuration()
+	}
+
+	crt, err := clientcert.Issue(ca, in.GetCsr(), id, ttl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error issuing client certificate: %s", err)
+	}
+
+	log.Printf("issued client certificate %q valid until %s", crt.X509Certificate.Subject, crt.X509Certificate.NotAfter)
+
+	reply = &machine.GenerateClientCertificateResponse{
+		Messages: []*machine.GenerateClientCertificate{
+			{
+				Ca:  ca.Crt,
+				Crt: crt.X509CertificatePEM,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
 // Reboot implements the machine.MachineServer interface.
 //
 // nolint: dupl
@@ -554,6 +611,33 @@ func (s *Server) ServiceList(ctx context.Context, in *empty.Empty) (result *mach
 	return result, nil
 }
 
+// NodeReady implements the health.NodeHealthServiceServer interface.
+func (s *Server) NodeReady(ctx context.Context, in *empty.Empty) (*healthapi.NodeReadyResponse, error) {
+	var required []string
+
+	if s.Controller.Runtime().Config() != nil {
+		required = system.RequiredServices(s.Controller.Runtime().Config().Machine().Type())
+
+		// custom health checks declared in the config are always required
+		for _, check := range s.Controller.Runtime().Config().Machine().HealthChecks() {
+			required = append(required, system.HealthCheckServiceID(check.Name()))
+		}
+	}
+
+	readiness := system.NodeReadiness(system.Services(s.Controller.Runtime()).List(), required)
+
+	if s.Controller.Runtime().Config() == nil {
+		readiness.Ready = false
+		readiness.Reasons = append(readiness.Reasons, "machine configuration is not loaded")
+	}
+
+	return &healthapi.NodeReadyResponse{
+		Messages: []*healthapi.NodeReady{
+			readiness,
+		},
+	}, nil
+}
+
 // ServiceStart implements the machine.MachineServer interface and starts a
 // service running on Talos.
 func (s *Server) ServiceStart(ctx context.Context, in *machine.ServiceStartRequest) (reply *machine.ServiceStartResponse, err error) {
@@ -1051,6 +1135,10 @@ func (s *Server) Kubeconfig(empty *empty.Empty, obj machine.MachineService_Kubec
 // log file are streamed in chunks.
 // nolint: gocyclo
 func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsServer) (err error) {
+	if isLogQuery(req) {
+		return s.queryLogs(req, l)
+	}
+
 	var chunk chunker.Chunker
 
 	switch {
@@ -1172,8 +1260,31 @@ func (s *Server) Read(in *machine.ReadRequest, srv machine.MachineService_ReadSe
 
 // Events streams runtime events.
 //
+// Events of the previous boots are read from the persisted event log, if events persistence is enabled.
+//
 //nolint: gocyclo
 func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_EventsServer) error {
+	currentBootID, err := eventlog.CurrentBootID()
+	if err != nil {
+		return fmt.Errorf("error reading boot ID: %w", err)
+	}
+
+	bootID, err := resolveBootID(req, currentBootID, s.eventLog)
+	if err != nil {
+		return err
+	}
+
+	filter := newEventFilter(req)
+
+	if bootID != currentBootID {
+		eventLog, logErr := s.eventLog()
+		if logErr != nil {
+			return logErr
+		}
+
+		return persistedEvents(req, eventLog, bootID, filter, l.Send)
+	}
+
 	errCh := make(chan error)
 
 	var opts []runtime.WatchOptionFunc
@@ -1183,7 +1294,9 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 	}
 
 	if req.TailId != "" {
-		tailID, err := xid.FromString(req.TailId)
+		var tailID xid.ID
+
+		tailID, err = xid.FromString(req.TailId)
 		if err != nil {
 			return fmt.Errorf("error parsing tail_id: %w", err)
 		}
@@ -1195,7 +1308,16 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 		opts = append(opts, runtime.WithTailDuration(time.Duration(req.TailSeconds)*time.Second))
 	}
 
-	if err := s.Controller.Runtime().Events().Watch(func(events <-chan runtime.Event) {
+	if req.Since != nil {
+		opts = append(opts, runtime.WithTailDuration(time.Since(req.Since.AsTime())))
+	}
+
+	if req.Until != nil {
+		// events stream is finished by the watcher once the events up to until are delivered
+		opts = append(opts, runtime.WithUntil(req.Until.AsTime()))
+	}
+
+	if err = s.Controller.Runtime().Events().Watch(func(events <-chan runtime.Event) {
 		errCh <- func() error {
 			for {
 				select {
@@ -1206,11 +1328,17 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 						return nil
 					}
 
+					if !filter.Matches(event.ID, event.Payload) {
+						continue
+					}
+
 					msg, err := event.ToMachineEvent()
 					if err != nil {
 						return err
 					}
 
+					msg.BootId = currentBootID
+
 					if err = l.Send(msg); err != nil {
 						return err
 					}
@@ -1224,6 +1352,21 @@ func (s *Server) Events(req *machine.EventsRequest, l machine.MachineService_Eve
 	return <-errCh
 }
 
+func (s *Server) eventLog() (*eventlog.Log, error) {
+	cfg := s.Controller.Runtime().Config().Machine().Events()
+
+	if !cfg.Persist() {
+		return nil, status.Error(codes.FailedPrecondition, "events persistence is not enabled")
+	}
+
+	dir, err := eventlog.Dir(cfg.Partition())
+	if err != nil {
+		return nil, err
+	}
+
+	return eventlog.New(dir, cfg.MaxSize()), nil
+}
+
 func pullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string) error {
 	// Pull down specified installer image early so we can bail if it doesn't exist in the upstream registry
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
@@ -1425,10 +1568,44 @@ func (s *Server) Restart(ctx context.Context, in *machine.RestartRequest) (*mach
 
 // Dmesg implements the machine.MachineServer interface.
 //
+// Messages are filtered by the level, facilities and since timestamp specified in the request.
+//
 //nolint: gocyclo
 func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_DmesgServer) error {
 	ctx := srv.Context()
 
+	maxPriority := kmsg.Debug
+
+	if req.Level != "" {
+		var err error
+
+		maxPriority, err = kmsg.ParsePriority(req.Level)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var facilities map[kmsg.Facility]struct{}
+
+	if len(req.Facilities) > 0 {
+		facilities = make(map[kmsg.Facility]struct{}, len(req.Facilities))
+
+		for _, name := range req.Facilities {
+			facility, err := kmsg.ParseFacility(name)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			facilities[facility] = struct{}{}
+		}
+	}
+
+	var since time.Time
+
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
 	var options []kmsg.Option
 
 	if req.Follow {
@@ -1459,15 +1636,34 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 			}
 
 			if packet.Err != nil {
-				err = srv.Send(&common.Data{
+				err = srv.Send(&machine.DmesgResponse{
 					Metadata: &common.Metadata{
 						Error: packet.Err.Error(),
 					},
 				})
 			} else {
 				msg := packet.Message
-				err = srv.Send(&common.Data{
-					Bytes: []byte(fmt.Sprintf("%s: %7s: [%s]: %s", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)),
+
+				if msg.Priority > maxPriority {
+					continue
+				}
+
+				if _, ok := facilities[msg.Facility]; facilities != nil && !ok {
+					continue
+				}
+
+				if !since.IsZero() && msg.Timestamp.Before(since) {
+					continue
+				}
+
+				err = srv.Send(&machine.DmesgResponse{
+					Bytes:     []byte(fmt.Sprintf("%s: %7s: [%s]: %s", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)),
+					Facility:  msg.Facility.String(),
+					Priority:  msg.Priority.String(),
+					Sequence:  msg.SequenceNumber,
+					Clock:     durationpb.New(time.Duration(msg.Clock) * time.Microsecond),
+					Timestamp: timestamppb.New(msg.Timestamp),
+					Message:   msg.Message,
 				})
 			}
 
@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/archiver"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// uploadAllowedPaths is the list of writable locations uploads can be extracted to.
+var uploadAllowedPaths = []string{
+	constants.EphemeralMountPoint,
+	constants.SystemOverlaysPath,
+}
+
+// Upload implements the machine.MachineServer interface and extracts .tar.gz archive
+// streamed by the client under the destination directory on the node.
+//
+// The first message should set the destination path, which should be located under
+// one of the writable locations.
+func (s *Server) Upload(srv machine.MachineService_UploadServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	path, err := uploadDestination(req.Path)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		var err error
+
+		for {
+			if len(req.Bytes) > 0 {
+				if _, err = pw.Write(req.Bytes); err != nil {
+					break
+				}
+			}
+
+			req, err = srv.Recv()
+			if err != nil {
+				break
+			}
+		}
+
+		if err == io.EOF {
+			err = nil
+		}
+
+		pw.CloseWithError(err) //nolint: errcheck
+	}()
+
+	err = archiver.UntarGz(srv.Context(), pr, path, archiver.WithOverwrite(), archiver.WithConfinedSymlinks())
+
+	// unblock the receiving goroutine if extraction stopped early
+	pr.CloseWithError(err) //nolint: errcheck
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "error extracting archive to %q: %s", path, err)
+	}
+
+	return srv.SendAndClose(&machine.UploadResponse{
+		Messages: []*machine.Upload{
+			{
+				Path: path,
+			},
+		},
+	})
+}
+
+// uploadDestination validates and creates upload destination directory.
+func uploadDestination(path string) (string, error) {
+	if path == "" {
+		return "", status.Error(codes.InvalidArgument, "destination path should be specified")
+	}
+
+	path = filepath.Clean(path)
+
+	if !filepath.IsAbs(path) {
+		return "", status.Errorf(codes.InvalidArgument, "path is not absolute %v", path)
+	}
+
+	if err := uploadPathAllowed(path); err != nil {
+		return "", err
+	}
+
+	// check the closest existing parent first, as it might be a symlink leading outside of the allowed locations
+	existing := path
+
+	for {
+		if _, err := os.Lstat(existing); err == nil || existing == string(os.PathSeparator) {
+			break
+		}
+
+		existing = filepath.Dir(existing)
+	}
+
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", fmt.Errorf("error resolving %q: %w", existing, err)
+	}
+
+	if err = uploadPathAllowed(resolved); err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(path, 0o755); err != nil {
+		return "", fmt.Errorf("error creating destination directory %q: %w", path, err)
+	}
+
+	if resolved, err = filepath.EvalSymlinks(path); err != nil {
+		return "", fmt.Errorf("error resolving destination directory %q: %w", path, err)
+	}
+
+	if err = uploadPathAllowed(resolved); err != nil {
+		return "", err
+	}
+
+	return resolved, nil
+}
+
+func uploadPathAllowed(path string) error {
+	for _, allowed := range uploadAllowedPaths {
+		if path == allowed || strings.HasPrefix(path, allowed+string(os.PathSeparator)) {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "path %q is not under writable locations %q", path, uploadAllowedPaths)
+}
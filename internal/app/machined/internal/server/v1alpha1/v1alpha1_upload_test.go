@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadDestination(t *testing.T) {
+	dir, err := ioutil.TempDir("", "upload")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	// temporary directory might be a symlink itself
+	dir, err = filepath.EvalSymlinks(dir)
+	require.NoError(t, err)
+
+	varDir := filepath.Join(dir, "var")
+	overlaysDir := filepath.Join(varDir, "system", "overlays")
+	etcDir := filepath.Join(dir, "etc")
+
+	require.NoError(t, os.MkdirAll(overlaysDir, 0o755))
+	require.NoError(t, os.MkdirAll(etcDir, 0o755))
+	require.NoError(t, os.Symlink(etcDir, filepath.Join(varDir, "etc")))
+
+	defer func(paths []string) {
+		uploadAllowedPaths = paths
+	}(uploadAllowedPaths)
+
+	uploadAllowedPaths = []string{varDir, overlaysDir}
+
+	for _, tt := range []struct {
+		name string
+		path string
+
+		expected string
+		code     codes.Code
+	}{
+		{
+			name:     "var",
+			path:     filepath.Join(varDir, "lib", "files"),
+			expected: filepath.Join(varDir, "lib", "files"),
+		},
+		{
+			name:     "overlays",
+			path:     filepath.Join(overlaysDir, "opt") + "/",
+			expected: filepath.Join(overlaysDir, "opt"),
+		},
+		{
+			name: "empty",
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "relative",
+			path: "var/lib",
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "etc",
+			path: etcDir,
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "traversal",
+			path: filepath.Join(varDir, "lib") + "/../../etc/cni",
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "prefix",
+			path: varDir + "iable",
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "symlink",
+			path: filepath.Join(varDir, "etc"),
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "symlink parent",
+			path: filepath.Join(varDir, "etc", "cni"),
+			code: codes.PermissionDenied,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := uploadDestination(tt.path)
+
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, path)
+			assert.DirExists(t, path)
+		})
+	}
+
+	// rejected destinations are not created
+	assert.NoDirExists(t, filepath.Join(etcDir, "cni"))
+	assert.NoDirExists(t, varDir+"iable")
+}
@@ -154,6 +154,9 @@ func main() {
 		log.Printf("controller runtime finished")
 	}()
 
+	// Start publishing events for networking, time and etcd membership changes.
+	c.StartEventSources(ctx)
+
 	// Initialize the machine.
 	if err = c.Run(runtime.SequenceInitialize, nil); err != nil {
 		handle(err)
@@ -209,11 +209,14 @@ func (ctrl *K8sControlPlaneController) manageExtraManifestsConfig(ctx context.Co
 	return r.Update(ctx, config.NewK8sExtraManifests(), func(r resource.Resource) error {
 		spec := config.K8sExtraManifestsSpec{}
 
+		digests := cfgProvider.Cluster().ExtraManifestDigestMap()
+
 		if cfgProvider.Cluster().Network().CNI().Name() == constants.CustomCNI {
 			for _, url := range cfgProvider.Cluster().Network().CNI().URLs() {
 				spec.ExtraManifests = append(spec.ExtraManifests, config.ExtraManifest{
 					URL:      url,
 					Priority: "05", // push CNI to the top
+					SHA256:   digests[url],
 				})
 			}
 		}
@@ -223,6 +226,24 @@ func (ctrl *K8sControlPlaneController) manageExtraManifestsConfig(ctx context.Co
 				URL:          url,
 				Priority:     "99", // make sure extra manifests come last, when PSP is already created
 				ExtraHeaders: cfgProvider.Cluster().ExtraManifestHeaderMap(),
+				SHA256:       digests[url],
+			})
+		}
+
+		if cfgProvider.Cluster().ExtraManifestTemplating() {
+			spec.TemplateVariables = &config.ExtraManifestTemplateVariables{
+				ClusterName:          cfgProvider.Cluster().Name(),
+				PodCIDR:              cfgProvider.Cluster().Network().PodCIDR(),
+				ServiceCIDR:          cfgProvider.Cluster().Network().ServiceCIDR(),
+				ControlPlaneEndpoint: cfgProvider.Cluster().Endpoint().String(),
+			}
+		}
+
+		for _, manifest := range cfgProvider.Cluster().InlineManifests() {
+			spec.InlineManifests = append(spec.InlineManifests, config.InlineManifest{
+				Name:     manifest.Name(),
+				Priority: "99", // make sure extra manifests come last, when PSP is already created
+				Contents: manifest.Contents(),
 			})
 		}
 
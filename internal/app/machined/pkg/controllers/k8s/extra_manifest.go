@@ -5,13 +5,19 @@
 package k8s
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/hashicorp/go-getter"
@@ -25,6 +31,11 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/resources/v1alpha1"
 )
 
+const (
+	extraManifestRetryMinInterval = 5 * time.Second
+	extraManifestRetryMaxInterval = 5 * time.Minute
+)
+
 // ExtraManifestController renders manifests based on templates and config/secrets.
 type ExtraManifestController struct{}
 
@@ -59,54 +70,86 @@ func (ctrl *ExtraManifestController) Run(ctx context.Context, r controller.Runti
 		return fmt.Errorf("error setting up dependencies: %w", err)
 	}
 
+	var (
+		retryInterval time.Duration
+		retryCh       <-chan time.Time
+	)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case <-retryCh:
 		}
 
-		// wait for networkd to be healthy as networking is required to download extra manifests
-		networkdResource, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "networkd", resource.VersionUndefined))
+		configResource, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.K8sControlPlaneType, config.K8sExtraManifestsID, resource.VersionUndefined))
 		if err != nil {
 			if state.IsNotFoundError(err) {
+				if err = ctrl.teardownAll(ctx, r); err != nil {
+					return fmt.Errorf("error tearing down: %w", err)
+				}
+
 				continue
 			}
 
 			return err
 		}
 
-		if !networkdResource.(*v1alpha1.Service).Healthy() {
-			continue
+		config := configResource.(*config.K8sControlPlane).ExtraManifests()
+
+		presentManifests := map[resource.ID]struct{}{}
+
+		// inline manifests don't require networking, so they are processed first
+		for _, manifest := range config.InlineManifests {
+			var id resource.ID
+
+			id, err = ctrl.processInline(ctx, r, manifest)
+			if err != nil {
+				return err
+			}
+
+			presentManifests[id] = struct{}{}
 		}
 
-		configResource, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.K8sControlPlaneType, config.K8sExtraManifestsID, resource.VersionUndefined))
-		if err != nil {
-			if state.IsNotFoundError(err) {
-				if err = ctrl.teardownAll(ctx, r); err != nil {
-					return fmt.Errorf("error tearing down: %w", err)
+		if len(config.ExtraManifests) > 0 {
+			// wait for networkd to be healthy as networking is required to download extra manifests
+			var networkdResource resource.Resource
+
+			networkdResource, err = r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "networkd", resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					continue
 				}
 
-				continue
+				return err
 			}
 
-			return err
+			if !networkdResource.(*v1alpha1.Service).Healthy() {
+				continue
+			}
 		}
 
-		config := configResource.(*config.K8sControlPlane).ExtraManifests()
-
 		var multiErr *multierror.Error
 
-		presentManifests := map[resource.ID]struct{}{}
+		failed := false
 
 		for _, manifest := range config.ExtraManifests {
-			var id resource.ID
+			var (
+				id      resource.ID
+				fetched bool
+			)
 
-			id, err = ctrl.download(ctx, r, logger, manifest)
+			id, fetched, err = ctrl.process(ctx, r, logger, manifest, config.TemplateVariables)
 			if err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 
+			if !fetched {
+				failed = true
+			}
+
+			// manifests which failed to be fetched are still considered present to keep previously fetched contents
 			presentManifests[id] = struct{}{}
 		}
 
@@ -114,6 +157,17 @@ func (ctrl *ExtraManifestController) Run(ctx context.Context, r controller.Runti
 			return multiErr.ErrorOrNil()
 		}
 
+		if failed {
+			retryInterval = nextRetryInterval(retryInterval)
+
+			logger.Printf("retrying failed extra manifests in %s", retryInterval)
+
+			retryCh = time.After(retryInterval)
+		} else {
+			retryInterval = 0
+			retryCh = nil
+		}
+
 		allManifests, err := r.List(ctx, resource.NewMetadata(k8s.ExtraNamespaceName, k8s.ManifestType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing extra manifests: %w", err)
@@ -129,16 +183,71 @@ func (ctrl *ExtraManifestController) Run(ctx context.Context, r controller.Runti
 	}
 }
 
-func (ctrl *ExtraManifestController) download(ctx context.Context, r controller.Runtime, logger *log.Logger, manifest config.ExtraManifest) (id resource.ID, err error) {
-	id = fmt.Sprintf("%s-%s", manifest.Priority, manifest.URL)
+// nextRetryInterval returns the interval before the next retry of the failed extra manifests.
+//
+// Interval is doubled on every retry up to the maximum.
+func nextRetryInterval(interval time.Duration) time.Duration {
+	if interval == 0 {
+		return extraManifestRetryMinInterval
+	}
 
-	var tmpDir string
+	interval *= 2
+
+	if interval > extraManifestRetryMaxInterval {
+		interval = extraManifestRetryMaxInterval
+	}
+
+	return interval
+}
+
+// process fetches the extra manifest and updates the Manifest resource.
+//
+// If the manifest can't be fetched, verified or parsed, previously fetched objects are kept,
+// the error is recorded in the Manifest resource, and fetched is false.
+func (ctrl *ExtraManifestController) process(ctx context.Context, r controller.Runtime, logger *log.Logger, manifest config.ExtraManifest,
+	vars *config.ExtraManifestTemplateVariables) (id resource.ID, fetched bool, err error) {
+	id = extraManifestID(manifest)
+
+	contents, fetchErr := ctrl.fetch(ctx, logger, manifest, vars)
+
+	if err = r.Update(ctx, k8s.NewManifest(k8s.ExtraNamespaceName, id),
+		func(r resource.Resource) error {
+			m := r.(*k8s.Manifest)
+
+			if fetchErr == nil {
+				if parseErr := m.SetYAML(contents); parseErr != nil {
+					fetchErr = fmt.Errorf("error parsing manifest %q: %w", manifest.URL, parseErr)
+				}
+			}
+
+			if fetchErr != nil {
+				m.SetFetchError(fetchErr.Error())
+			}
+
+			return nil
+		}); err != nil {
+		err = fmt.Errorf("error updating manifests: %w", err)
 
-	tmpDir, err = ioutil.TempDir("", "talos")
-	if err != nil {
 		return
 	}
 
+	if fetchErr != nil {
+		logger.Printf("failed to process manifest: %s", fetchErr)
+
+		return id, false, nil
+	}
+
+	return id, true, nil
+}
+
+// fetch downloads the extra manifest, verifies its digest and renders it as a template if enabled.
+func (ctrl *ExtraManifestController) fetch(ctx context.Context, logger *log.Logger, manifest config.ExtraManifest,
+	vars *config.ExtraManifestTemplateVariables) ([]byte, error) {
+	tmpDir, err := ioutil.TempDir("", "talos")
+	if err != nil {
+		return nil, err
+	}
+
 	defer os.RemoveAll(tmpDir) //nolint: errcheck
 
 	fileName := filepath.Base(manifest.URL)
@@ -173,32 +282,73 @@ func (ctrl *ExtraManifestController) download(ctx context.Context, r controller.
 	}
 
 	if err = client.Get(); err != nil {
-		err = fmt.Errorf("error downloading %q: %w", manifest.URL, err)
-
-		return
+		return nil, fmt.Errorf("error downloading %q: %w", manifest.URL, err)
 	}
 
 	logger.Printf("downloaded manifest %q", manifest.URL)
 
-	var contents []byte
+	contents, err := ioutil.ReadFile(client.Dst)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.SHA256 != "" {
+		digest := sha256.Sum256(contents)
 
-	contents, err = ioutil.ReadFile(client.Dst)
+		if actual := hex.EncodeToString(digest[:]); !strings.EqualFold(actual, manifest.SHA256) {
+			return nil, fmt.Errorf("digest mismatch for manifest %q: expected sha256 %s, got %s", manifest.URL, strings.ToLower(manifest.SHA256), actual)
+		}
+	}
+
+	if vars != nil {
+		contents, err = renderExtraManifest(manifest.URL, contents, vars)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return contents, nil
+}
+
+// renderExtraManifest renders manifest contents as a Go template.
+func renderExtraManifest(name string, contents []byte, vars *config.ExtraManifestTemplateVariables) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(string(contents))
 	if err != nil {
-		return
+		return nil, fmt.Errorf("error parsing manifest template %q: %w", name, err)
 	}
 
-	if err = r.Update(ctx, k8s.NewManifest(k8s.ExtraNamespaceName, id),
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, vars); err != nil {
+		return nil, fmt.Errorf("error rendering manifest template %q: %w", name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (ctrl *ExtraManifestController) processInline(ctx context.Context, r controller.Runtime, manifest config.InlineManifest) (resource.ID, error) {
+	id := inlineManifestID(manifest)
+
+	if err := r.Update(ctx, k8s.NewManifest(k8s.ExtraNamespaceName, id),
 		func(r resource.Resource) error {
-			return r.(*k8s.Manifest).SetYAML(contents)
+			return r.(*k8s.Manifest).SetYAML([]byte(manifest.Contents))
 		}); err != nil {
-		err = fmt.Errorf("error updating manifests: %w", err)
-
-		return
+		return id, fmt.Errorf("error updating manifests: %w", err)
 	}
 
 	return id, nil
 }
 
+// inlineManifestID returns the ID of the Manifest resource for the inline manifest.
+func inlineManifestID(manifest config.InlineManifest) resource.ID {
+	return fmt.Sprintf("%s-%s", manifest.Priority, manifest.Name)
+}
+
+// extraManifestID returns the ID of the Manifest resource for the extra manifest.
+func extraManifestID(manifest config.ExtraManifest) resource.ID {
+	return fmt.Sprintf("%s-%s", manifest.Priority, manifest.URL)
+}
+
 func (ctrl *ExtraManifestController) teardownAll(ctx context.Context, r controller.Runtime) error {
 	manifests, err := r.List(ctx, resource.NewMetadata(k8s.ExtraNamespaceName, k8s.ManifestType, "", resource.VersionUndefined))
 	if err != nil {
@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/resources/config"
+)
+
+const testManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cluster-info
+  namespace: kube-system
+data:
+  cluster: {{ .ClusterName }}
+  pods: {{ .PodCIDR }}
+`
+
+func serveManifest(t *testing.T, failures int32) (*httptest.Server, *int32) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		fmt.Fprint(w, testManifest) //nolint: errcheck
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+func sha256Hex(contents string) string {
+	digest := sha256.Sum256([]byte(contents))
+
+	return hex.EncodeToString(digest[:])
+}
+
+func TestExtraManifestFetch(t *testing.T) {
+	srv, _ := serveManifest(t, 0)
+
+	ctrl := &ExtraManifestController{}
+	logger := log.New(ioutil.Discard, "", 0)
+
+	contents, err := ctrl.fetch(context.Background(), logger, config.ExtraManifest{
+		URL:    srv.URL + "/manifest.yaml",
+		SHA256: strings.ToUpper(sha256Hex(testManifest)),
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, testManifest, string(contents))
+}
+
+func TestExtraManifestDigestMismatch(t *testing.T) {
+	srv, _ := serveManifest(t, 0)
+
+	ctrl := &ExtraManifestController{}
+	logger := log.New(ioutil.Discard, "", 0)
+
+	expected := sha256Hex("something else")
+
+	_, err := ctrl.fetch(context.Background(), logger, config.ExtraManifest{
+		URL:    srv.URL + "/manifest.yaml",
+		SHA256: expected,
+	}, nil)
+	assert.EqualError(t, err, fmt.Sprintf("digest mismatch for manifest %q: expected sha256 %s, got %s", srv.URL+"/manifest.yaml", expected, sha256Hex(testManifest)))
+}
+
+func TestExtraManifestRetry(t *testing.T) {
+	srv, requests := serveManifest(t, 2)
+
+	ctrl := &ExtraManifestController{}
+	logger := log.New(ioutil.Discard, "", 0)
+
+	manifest := config.ExtraManifest{
+		URL: srv.URL + "/manifest.yaml",
+	}
+
+	var interval time.Duration
+
+	// 5xx responses fail the download, and the download is retried with the growing interval
+	for i := 0; i < 2; i++ {
+		_, err := ctrl.fetch(context.Background(), logger, manifest, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "503")
+
+		interval = nextRetryInterval(interval)
+	}
+
+	assert.Equal(t, 2*extraManifestRetryMinInterval, interval)
+
+	contents, err := ctrl.fetch(context.Background(), logger, manifest, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, testManifest, string(contents))
+	assert.EqualValues(t, 3, atomic.LoadInt32(requests))
+}
+
+func TestNextRetryInterval(t *testing.T) {
+	var intervals []time.Duration
+
+	interval := time.Duration(0)
+
+	for i := 0; i < 9; i++ {
+		interval = nextRetryInterval(interval)
+		intervals = append(intervals, interval)
+	}
+
+	assert.Equal(t, []time.Duration{
+		5 * time.Second,
+		10 * time.Second,
+		20 * time.Second,
+		40 * time.Second,
+		80 * time.Second,
+		160 * time.Second,
+		5 * time.Minute,
+		5 * time.Minute,
+		5 * time.Minute,
+	}, intervals)
+}
+
+func TestExtraManifestTemplate(t *testing.T) {
+	srv, _ := serveManifest(t, 0)
+
+	ctrl := &ExtraManifestController{}
+	logger := log.New(ioutil.Discard, "", 0)
+
+	// digest is verified before the template is rendered
+	contents, err := ctrl.fetch(context.Background(), logger, config.ExtraManifest{
+		URL:    srv.URL + "/manifest.yaml",
+		SHA256: sha256Hex(testManifest),
+	}, &config.ExtraManifestTemplateVariables{
+		ClusterName: "talos-default",
+		PodCIDR:     "10.244.0.0/16",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cluster-info
+  namespace: kube-system
+data:
+  cluster: talos-default
+  pods: 10.244.0.0/16
+`, string(contents))
+
+	_, err = renderExtraManifest("broken.yaml", []byte("{{ .Unknown }}"), &config.ExtraManifestTemplateVariables{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `error rendering manifest template "broken.yaml"`)
+	assert.Contains(t, err.Error(), "can't evaluate field Unknown")
+}
@@ -147,11 +147,36 @@ func (ctrl *ManifestController) render(cfg config.K8sManifestsSpec, scrt secrets
 		Secrets:          scrt,
 	}
 
-	type manifestDesc struct {
-		name     string
-		template []byte
+	defaultManifests := manifestTemplates(cfg)
+
+	manifests := make([]renderedManifest, len(defaultManifests))
+
+	for i := range defaultManifests {
+		tmpl, err := template.New(defaultManifests[i].name).Parse(string(defaultManifests[i].template))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing manifest template %q: %w", defaultManifests[i].name, err)
+		}
+
+		var buf bytes.Buffer
+
+		if err = tmpl.Execute(&buf, &templateConfig); err != nil {
+			return nil, fmt.Errorf("error executing template %q: %w", defaultManifests[i].name, err)
+		}
+
+		manifests[i].name = defaultManifests[i].name
+		manifests[i].data = buf.Bytes()
 	}
 
+	return manifests, nil
+}
+
+type manifestDesc struct {
+	name     string
+	template []byte
+}
+
+// manifestTemplates returns the list of manifests to be rendered for the config.
+func manifestTemplates(cfg config.K8sManifestsSpec) []manifestDesc {
 	defaultManifests := []manifestDesc{
 		{"00-kubelet-bootstrapping-token", kubeletBootstrappingToken},
 		{"01-csr-node-bootstrap", csrNodeBootstrapTemplate},
@@ -181,25 +206,7 @@ func (ctrl *ManifestController) render(cfg config.K8sManifestsSpec, scrt secrets
 		)
 	}
 
-	manifests := make([]renderedManifest, len(defaultManifests))
-
-	for i := range defaultManifests {
-		tmpl, err := template.New(defaultManifests[i].name).Parse(string(defaultManifests[i].template))
-		if err != nil {
-			return nil, fmt.Errorf("error parsing manifest template %q: %w", defaultManifests[i].name, err)
-		}
-
-		var buf bytes.Buffer
-
-		if err = tmpl.Execute(&buf, &templateConfig); err != nil {
-			return nil, fmt.Errorf("error executing template %q: %w", defaultManifests[i].name, err)
-		}
-
-		manifests[i].name = defaultManifests[i].name
-		manifests[i].data = buf.Bytes()
-	}
-
-	return manifests, nil
+	return defaultManifests
 }
 
 func (ctrl *ManifestController) teardownAll(ctx context.Context, r controller.Runtime) error {
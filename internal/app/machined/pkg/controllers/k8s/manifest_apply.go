@@ -8,9 +8,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/AlekSi/pointer"
+	"github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/os-runtime/pkg/controller"
 	"github.com/talos-systems/os-runtime/pkg/resource"
 	"github.com/talos-systems/os-runtime/pkg/state"
@@ -20,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	memory "k8s.io/client-go/discovery/cached"
 	"k8s.io/client-go/dynamic"
@@ -28,6 +34,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/resources/config"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/resources/k8s"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/resources/secrets"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/resources/v1alpha1"
@@ -35,8 +42,21 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
+const (
+	manifestApplyElectionTTL   = 15 // seconds
+	manifestApplyElectionRetry = 10 * time.Second
+)
+
 // ManifestApplyController applies manifests via control plane endpoint.
-type ManifestApplyController struct{}
+//
+// Objects are applied with server-side apply and labeled as owned by Talos.
+// Owned objects which are no longer part of any manifest are pruned.
+//
+// Manifests are applied only by the control plane node elected as the leader via etcd,
+// so that control plane nodes with different machine configs don't fight over the objects.
+type ManifestApplyController struct {
+	leader int32
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *ManifestApplyController) Name() string {
@@ -69,6 +89,18 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 			Type:      k8s.ManifestType,
 			Kind:      controller.DependencyWeak,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.K8sControlPlaneType,
+			ID:        pointer.ToString(config.K8sManifestsID),
+			Kind:      controller.DependencyWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.K8sControlPlaneType,
+			ID:        pointer.ToString(config.K8sExtraManifestsID),
+			Kind:      controller.DependencyWeak,
+		},
 		{
 			Namespace: v1alpha1.NamespaceName,
 			Type:      v1alpha1.BootstrapStatusType,
@@ -79,6 +111,12 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 		return fmt.Errorf("error setting up dependencies: %w", err)
 	}
 
+	// election is stopped when the controller is restarted
+	campaignCtx, campaignCancel := context.WithCancel(ctx)
+	defer campaignCancel()
+
+	campaignStarted := false
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -112,6 +150,16 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 			continue
 		}
 
+		if !campaignStarted {
+			campaignStarted = true
+
+			go ctrl.campaign(campaignCtx, r, logger)
+		}
+
+		if atomic.LoadInt32(&ctrl.leader) == 0 {
+			continue
+		}
+
 		manifests, err := r.List(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.ManifestType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing manifests: %w", err)
@@ -128,11 +176,17 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 			return manifests.Items[i].Metadata().ID() < manifests.Items[j].Metadata().ID()
 		})
 
+		var (
+			objectStatuses []k8s.ManifestObjectStatus
+			applyErr       error
+		)
+
 		if len(manifests.Items) > 0 {
 			var (
 				kubeconfig *rest.Config
 				dc         *discovery.DiscoveryClient
 				dyn        dynamic.Interface
+				prune      bool
 			)
 
 			kubeconfig, err = clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
@@ -147,36 +201,200 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 				return fmt.Errorf("error building discovery client: %w", err)
 			}
 
-			mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
+			cachedDC := memory.NewMemCacheClient(dc)
+			mapper := restmapper.NewDeferredDiscoveryRESTMapper(cachedDC)
 
 			dyn, err = dynamic.NewForConfig(kubeconfig)
 			if err != nil {
 				return fmt.Errorf("error building dynamic client: %w", err)
 			}
 
-			if err = ctrl.etcdLock(ctx, logger, func() error {
-				return ctrl.apply(ctx, logger, mapper, dyn, manifests)
-			}); err != nil {
+			prune, err = ctrl.allManifestsRendered(ctx, r, manifests)
+			if err != nil {
 				return err
 			}
+
+			applyErr = ctrl.etcdLock(ctx, logger, func() error {
+				var (
+					appliedUIDs map[types.UID]struct{}
+					lockedErr   error
+				)
+
+				objectStatuses, appliedUIDs, lockedErr = ctrl.apply(ctx, logger, mapper, dyn, manifests)
+				if lockedErr != nil {
+					return lockedErr
+				}
+
+				if !prune {
+					logger.Printf("skipped pruning as not all manifests are rendered yet")
+
+					return nil
+				}
+
+				var prunedStatuses []k8s.ManifestObjectStatus
+
+				prunedStatuses, lockedErr = ctrl.prune(ctx, logger, cachedDC, dyn, appliedUIDs)
+				objectStatuses = append(objectStatuses, prunedStatuses...)
+
+				return lockedErr
+			})
+		}
+
+		// status is recorded even if some objects failed to apply
+		if err = ctrl.updateStatus(ctx, r, manifests, objectStatuses); err != nil {
+			return err
+		}
+
+		if applyErr != nil {
+			return applyErr
 		}
+	}
+}
 
-		if err = r.Update(ctx, k8s.NewManifestStatus(k8s.ControlPlaneNamespaceName), func(r resource.Resource) error {
-			status := r.(*k8s.ManifestStatus).Status()
+func (ctrl *ManifestApplyController) updateStatus(ctx context.Context, r controller.Runtime, manifests resource.List, objectStatuses []k8s.ManifestObjectStatus) error {
+	if err := r.Update(ctx, k8s.NewManifestStatus(k8s.ControlPlaneNamespaceName), func(r resource.Resource) error {
+		status := r.(*k8s.ManifestStatus).Status()
 
-			status.ManifestsApplied = make([]string, 0, len(manifests.Items))
+		status.ManifestsApplied = make([]string, 0, len(manifests.Items))
 
-			for _, manifest := range manifests.Items {
-				status.ManifestsApplied = append(status.ManifestsApplied, manifest.Metadata().ID())
-			}
+		for _, manifest := range manifests.Items {
+			status.ManifestsApplied = append(status.ManifestsApplied, manifest.Metadata().ID())
+		}
 
-			return nil
-		}); err != nil {
-			return fmt.Errorf("error updating manifest status: %w", err)
+		status.Objects = objectStatuses
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating manifest status: %w", err)
+	}
+
+	return nil
+}
+
+// allManifestsRendered checks whether every manifest expected from the machine config is rendered.
+//
+// Objects are pruned only when the set of manifests is complete, otherwise objects of the manifest
+// which is not rendered yet (e.g. extra manifest being downloaded) would be removed from the cluster.
+func (ctrl *ManifestApplyController) allManifestsRendered(ctx context.Context, r controller.Runtime, manifests resource.List) (bool, error) {
+	rendered := map[string]struct{}{}
+
+	for _, manifest := range manifests.Items {
+		// manifest which failed to be fetched might have stale contents
+		if manifest.(*k8s.Manifest).FetchError() != "" {
+			continue
+		}
+
+		rendered[manifest.Metadata().Namespace()+"/"+manifest.Metadata().ID()] = struct{}{}
+	}
+
+	manifestsConfig, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.K8sControlPlaneType, config.K8sManifestsID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	extraManifestsConfig, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.K8sControlPlaneType, config.K8sExtraManifestsID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	var expected []string
+
+	for _, desc := range manifestTemplates(manifestsConfig.(*config.K8sControlPlane).Manifests()) {
+		expected = append(expected, k8s.ControlPlaneNamespaceName+"/"+desc.name)
+	}
+
+	for _, extraManifest := range extraManifestsConfig.(*config.K8sControlPlane).ExtraManifests().ExtraManifests {
+		expected = append(expected, k8s.ExtraNamespaceName+"/"+extraManifestID(extraManifest))
+	}
+
+	for _, inlineManifest := range extraManifestsConfig.(*config.K8sControlPlane).ExtraManifests().InlineManifests {
+		expected = append(expected, k8s.ExtraNamespaceName+"/"+inlineManifestID(inlineManifest))
+	}
+
+	for _, id := range expected {
+		if _, ok := rendered[id]; !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// campaign runs the election for the manifest apply leader until ctx is canceled.
+//
+// Controller is reconciled whenever the leadership changes.
+func (ctrl *ManifestApplyController) campaign(ctx context.Context, r controller.Runtime, logger *log.Logger) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	for {
+		if err = ctrl.lead(ctx, r, logger, hostname); err != nil {
+			logger.Printf("manifest apply leader election failed: %s", err)
 		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(manifestApplyElectionRetry):
+		}
+	}
+}
+
+// lead waits to be elected as the leader, and keeps the leadership while the etcd session is alive.
+func (ctrl *ManifestApplyController) lead(ctx context.Context, r controller.Runtime, logger *log.Logger, hostname string) error {
+	etcdClient, err := etcd.NewLocalClient()
+	if err != nil {
+		return fmt.Errorf("error creating etcd client: %w", err)
+	}
+
+	defer etcdClient.Close() //nolint: errcheck
+
+	session, err := concurrency.NewSession(etcdClient.Client, concurrency.WithTTL(manifestApplyElectionTTL))
+	if err != nil {
+		return fmt.Errorf("error creating etcd session: %w", err)
+	}
+
+	defer session.Close() //nolint: errcheck
+
+	election := concurrency.NewElection(session, constants.EtcdTalosManifestApplyElection)
+
+	if err = election.Campaign(ctx, hostname); err != nil {
+		return fmt.Errorf("error campaigning: %w", err)
+	}
+
+	logger.Printf("elected as the manifest apply leader")
+
+	atomic.StoreInt32(&ctrl.leader, 1)
+	r.QueueReconcile()
+
+	defer func() {
+		atomic.StoreInt32(&ctrl.leader, 0)
+
+		logger.Printf("lost the manifest apply leadership")
+	}()
+
+	select {
+	case <-ctx.Done():
+		resignCtx, resignCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer resignCancel()
+
+		return election.Resign(resignCtx)
+	case <-session.Done():
+		return fmt.Errorf("etcd session expired")
 	}
 }
 
+// etcdLock serializes the changes to the manifests, as the leadership might overlap while it changes hands.
 func (ctrl *ManifestApplyController) etcdLock(ctx context.Context, logger *log.Logger, f func() error) error {
 	etcdClient, err := etcd.NewLocalClient()
 	if err != nil {
@@ -207,19 +425,35 @@ func (ctrl *ManifestApplyController) etcdLock(ctx context.Context, logger *log.L
 	return f()
 }
 
+// manifestObject is a Kubernetes object with the ID of the manifest it comes from.
+type manifestObject struct {
+	manifest resource.ID
+	obj      *unstructured.Unstructured
+}
+
+// apply the objects from the manifests with server-side apply.
+//
+// Objects are applied in order, errors are recorded in the returned status and do not stop
+// applying the remaining objects.
+//
 //nolint: gocyclo
-func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *log.Logger, mapper *restmapper.DeferredDiscoveryRESTMapper, dyn dynamic.Interface, manifests resource.List) error {
+func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *log.Logger, mapper *restmapper.DeferredDiscoveryRESTMapper, dyn dynamic.Interface, manifests resource.List) ([]k8s.ManifestObjectStatus, map[types.UID]struct{}, error) {
 	// flatten list of objects to be applied
-	objects := make([]*unstructured.Unstructured, 0, len(manifests.Items))
+	objects := make([]manifestObject, 0, len(manifests.Items))
 
 	for _, manifest := range manifests.Items {
-		objects = append(objects, manifest.(*k8s.Manifest).Objects()...)
+		for _, obj := range manifest.(*k8s.Manifest).Objects() {
+			objects = append(objects, manifestObject{
+				manifest: manifest.Metadata().ID(),
+				obj:      obj.DeepCopy(),
+			})
+		}
 	}
 
 	// sort the list so that namespaces come first, followed by CRDs and everything else after that
 	sort.SliceStable(objects, func(i, j int) bool {
-		objL := objects[i]
-		objR := objects[j]
+		objL := objects[i].obj
+		objR := objects[j].obj
 
 		gvkL := objL.GroupVersionKind()
 		gvkR := objR.GroupVersionKind()
@@ -251,49 +485,265 @@ func (ctrl *ManifestApplyController) apply(ctx context.Context, logger *log.Logg
 		return false
 	})
 
-	for _, obj := range objects {
-		gvk := obj.GroupVersionKind()
-		objName := fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Version, gvk.Kind, obj.GetName())
+	statuses := make([]k8s.ManifestObjectStatus, 0, len(objects))
+	appliedUIDs := make(map[types.UID]struct{}, len(objects))
 
-		mapping, err := mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
-		if err != nil {
-			return fmt.Errorf("error creating mapping for object %s: %w", objName, err)
+	var multiErr *multierror.Error
+
+	// manifests which failed to be fetched keep previous contents, so the error is only recorded
+	for _, manifest := range manifests.Items {
+		if fetchErr := manifest.(*k8s.Manifest).FetchError(); fetchErr != "" {
+			statuses = append(statuses, k8s.ManifestObjectStatus{
+				Manifest: manifest.Metadata().ID(),
+				Action:   k8s.ManifestActionFailed,
+				Error:    fetchErr,
+			})
 		}
+	}
 
-		var dr dynamic.ResourceInterface
-		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
-			// namespaced resources should specify the namespace
-			dr = dyn.Resource(mapping.Resource).Namespace(obj.GetNamespace())
-		} else {
-			// for cluster-wide resources
-			dr = dyn.Resource(mapping.Resource)
+	for _, object := range objects {
+		objName := objectName(object.obj)
+
+		status := k8s.ManifestObjectStatus{
+			Manifest: object.manifest,
+			Object:   objName,
+			Action:   k8s.ManifestActionApplied,
 		}
 
-		_, err = dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
-		if err == nil {
-			// already exists
-			continue
+		uid, err := ctrl.applyObject(ctx, logger, mapper, dyn, object)
+		if err != nil {
+			err = fmt.Errorf("error applying %s: %w", objName, err)
+
+			status.Action = k8s.ManifestActionFailed
+			status.Error = err.Error()
+
+			multiErr = multierror.Append(multiErr, err)
+		} else {
+			appliedUIDs[uid] = struct{}{}
 		}
 
-		if !apierrors.IsNotFound(err) {
-			return fmt.Errorf("error checking resource existence: %w", err)
+		statuses = append(statuses, status)
+	}
+
+	return statuses, appliedUIDs, multiErr.ErrorOrNil()
+}
+
+// applyObject applies a single object marking it as owned by Talos.
+//
+// Server-side apply is forced, so that fields managed by Talos are reset to the values from the manifest.
+func (ctrl *ManifestApplyController) applyObject(ctx context.Context, logger *log.Logger, mapper *restmapper.DeferredDiscoveryRESTMapper, dyn dynamic.Interface, object manifestObject) (types.UID, error) {
+	obj := object.obj
+	gvk := obj.GroupVersionKind()
+
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return "", fmt.Errorf("error creating mapping: %w", err)
+	}
+
+	var dr dynamic.ResourceInterface
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		// namespaced resources should specify the namespace
+		dr = dyn.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+	} else {
+		// for cluster-wide resources
+		dr = dyn.Resource(mapping.Resource)
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels[constants.LabelManifestOwner] = constants.LabelManifestOwnerValue
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[constants.AnnotationManifest] = object.manifest
+	obj.SetAnnotations(annotations)
+
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("error marshaling object: %w", err)
+	}
+
+	var resourceVersion string
+
+	existing, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
+
+	switch {
+	case err == nil:
+		resourceVersion = existing.GetResourceVersion()
+	case apierrors.IsNotFound(err):
+	default:
+		return "", fmt.Errorf("error checking resource existence: %w", err)
+	}
+
+	applied, err := dr.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: constants.KubernetesFieldManager,
+		Force:        pointer.ToBool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case resourceVersion == "":
+		logger.Printf("created %s", objectName(obj))
+	case resourceVersion != applied.GetResourceVersion():
+		logger.Printf("updated %s", objectName(obj))
+	}
+
+	return applied.GetUID(), nil
+}
+
+// prune removes objects owned by Talos which are not part of the applied manifests.
+//
+// Every resource type which can be listed and deleted is checked, as the manifest which
+// created the object might be removed from the machine config.
+//
+//nolint: gocyclo
+func (ctrl *ManifestApplyController) prune(ctx context.Context, logger *log.Logger, dc discovery.DiscoveryInterface, dyn dynamic.Interface, appliedUIDs map[types.UID]struct{}) ([]k8s.ManifestObjectStatus, error) {
+	resourceLists, err := discovery.ServerPreferredResources(dc)
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, fmt.Errorf("error discovering resources: %w", err)
 		}
 
-		_, err = dr.Create(ctx, obj, metav1.CreateOptions{
-			FieldManager: "talos",
-		})
+		// objects of the groups which failed discovery are not pruned
+		logger.Printf("partial resource discovery failure: %s", err)
+	}
+
+	selector := fmt.Sprintf("%s=%s", constants.LabelManifestOwner, constants.LabelManifestOwnerValue)
+	pruned := map[types.UID]struct{}{}
+
+	var statuses []k8s.ManifestObjectStatus
+
+	for _, resourceList := range resourceLists {
+		var gv schema.GroupVersion
+
+		gv, err = schema.ParseGroupVersion(resourceList.GroupVersion)
 		if err != nil {
-			if apierrors.IsAlreadyExists(err) {
-				// later on we might want to do something here, e.g. do server-side apply, for now do nothing
-			} else {
-				return fmt.Errorf("error creating %s: %w", objName, err)
+			return statuses, fmt.Errorf("error parsing group version %q: %w", resourceList.GroupVersion, err)
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			if !isPrunable(apiResource) {
+				continue
+			}
+
+			gvr := gv.WithResource(apiResource.Name)
+
+			var items *unstructured.UnstructuredList
+
+			items, err = dyn.Resource(gvr).List(ctx, metav1.ListOptions{
+				LabelSelector: selector,
+			})
+			if err != nil {
+				return statuses, fmt.Errorf("error listing %s: %w", gvr, err)
+			}
+
+			for _, item := range items.Items {
+				item := item
+
+				manifest, ok := shouldPrune(&item, appliedUIDs, pruned)
+				if !ok {
+					continue
+				}
+
+				objName := objectName(&item)
+
+				var dr dynamic.ResourceInterface
+				if apiResource.Namespaced {
+					dr = dyn.Resource(gvr).Namespace(item.GetNamespace())
+				} else {
+					dr = dyn.Resource(gvr)
+				}
+
+				uid := item.GetUID()
+				propagation := metav1.DeletePropagationBackground
+
+				if err = dr.Delete(ctx, item.GetName(), metav1.DeleteOptions{
+					Preconditions:     &metav1.Preconditions{UID: &uid},
+					PropagationPolicy: &propagation,
+				}); err != nil && !apierrors.IsNotFound(err) {
+					return statuses, fmt.Errorf("error pruning %s: %w", objName, err)
+				}
+
+				logger.Printf("pruned %s", objName)
+
+				pruned[uid] = struct{}{}
+
+				statuses = append(statuses, k8s.ManifestObjectStatus{
+					Manifest: manifest,
+					Object:   objName,
+					Action:   k8s.ManifestActionPruned,
+				})
 			}
-		} else {
-			logger.Printf("created %s", objName)
 		}
 	}
 
-	return nil
+	return statuses, nil
+}
+
+// isPrunable checks whether the objects of the resource can be pruned.
+//
+// Subresources are skipped, and the resource should support listing and deletion.
+func isPrunable(apiResource metav1.APIResource) bool {
+	return !strings.Contains(apiResource.Name, "/") && hasVerbs(apiResource.Verbs, "list", "delete")
+}
+
+// shouldPrune checks whether the object listed with the owner label should be pruned.
+//
+// It returns the ID of the manifest the object came from.
+func shouldPrune(item *unstructured.Unstructured, appliedUIDs, pruned map[types.UID]struct{}) (string, bool) {
+	// labels might be copied by Kubernetes controllers (e.g. from Service to Endpoints),
+	// so only objects which carry both the label and the annotation are owned by Talos
+	manifest, owned := item.GetAnnotations()[constants.AnnotationManifest]
+	if !owned || item.GetDeletionTimestamp() != nil {
+		return "", false
+	}
+
+	if _, ok := appliedUIDs[item.GetUID()]; ok {
+		return "", false
+	}
+
+	// same object might be listed under different groups
+	if _, ok := pruned[item.GetUID()]; ok {
+		return "", false
+	}
+
+	return manifest, true
+}
+
+// objectName formats object reference as group/version/kind/namespace/name.
+func objectName(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+
+	return fmt.Sprintf("%s/%s/%s/%s/%s", gvk.Group, gvk.Version, gvk.Kind, obj.GetNamespace(), obj.GetName())
+}
+
+func hasVerbs(verbs metav1.Verbs, required ...string) bool {
+	for _, verb := range required {
+		found := false
+
+		for _, v := range verbs {
+			if v == verb {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
 }
 
 func isNamespace(gvk schema.GroupVersionKind) bool {
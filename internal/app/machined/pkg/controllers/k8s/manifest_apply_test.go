@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func TestIsPrunable(t *testing.T) {
+	for _, tt := range []struct {
+		resource metav1.APIResource
+		expected bool
+	}{
+		{
+			resource: metav1.APIResource{Name: "configmaps", Verbs: metav1.Verbs{"create", "delete", "get", "list", "patch"}},
+			expected: true,
+		},
+		{
+			resource: metav1.APIResource{Name: "pods/status", Verbs: metav1.Verbs{"get", "list", "delete"}},
+			expected: false,
+		},
+		{
+			resource: metav1.APIResource{Name: "componentstatuses", Verbs: metav1.Verbs{"get", "list"}},
+			expected: false,
+		},
+		{
+			resource: metav1.APIResource{Name: "tokenreviews", Verbs: metav1.Verbs{"create", "delete"}},
+			expected: false,
+		},
+	} {
+		assert.Equal(t, tt.expected, isPrunable(tt.resource), tt.resource.Name)
+	}
+}
+
+func TestShouldPrune(t *testing.T) {
+	object := func(uid types.UID, annotated, deleted bool) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetUID(uid)
+		obj.SetLabels(map[string]string{constants.LabelManifestOwner: constants.LabelManifestOwnerValue})
+
+		if annotated {
+			obj.SetAnnotations(map[string]string{constants.AnnotationManifest: "10-kube-proxy"})
+		}
+
+		if deleted {
+			obj.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+		}
+
+		return obj
+	}
+
+	applied := map[types.UID]struct{}{"applied": {}}
+	pruned := map[types.UID]struct{}{"pruned": {}}
+
+	for _, tt := range []struct {
+		name     string
+		obj      *unstructured.Unstructured
+		expected bool
+	}{
+		{
+			name:     "removed from manifests",
+			obj:      object("stale", true, false),
+			expected: true,
+		},
+		{
+			name: "still in manifests",
+			obj:  object("applied", true, false),
+		},
+		{
+			name: "label copied by controller",
+			obj:  object("copied", false, false),
+		},
+		{
+			name: "being deleted",
+			obj:  object("deleted", true, true),
+		},
+		{
+			name: "listed under another group",
+			obj:  object("pruned", true, false),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, ok := shouldPrune(tt.obj, applied, pruned)
+
+			assert.Equal(t, tt.expected, ok)
+
+			if tt.expected {
+				assert.Equal(t, "10-kube-proxy", manifest)
+			}
+		})
+	}
+}
@@ -89,11 +89,29 @@ type ExtraManifest struct {
 	URL          string            `yaml:"url"`
 	Priority     string            `yaml:"priority"`
 	ExtraHeaders map[string]string `yaml:"extraHeaders"`
+	SHA256       string            `yaml:"sha256,omitempty"`
+}
+
+// InlineManifest defines a single inline manifest.
+type InlineManifest struct {
+	Name     string `yaml:"name"`
+	Priority string `yaml:"priority"`
+	Contents string `yaml:"contents"`
 }
 
 // K8sExtraManifestsSpec is a configuration for extra manifests.
 type K8sExtraManifestsSpec struct {
-	ExtraManifests []ExtraManifest `yaml:"extraManifests"`
+	ExtraManifests    []ExtraManifest                 `yaml:"extraManifests"`
+	InlineManifests   []InlineManifest                `yaml:"inlineManifests"`
+	TemplateVariables *ExtraManifestTemplateVariables `yaml:"templateVariables,omitempty"`
+}
+
+// ExtraManifestTemplateVariables defines variables available when rendering extra manifests as templates.
+type ExtraManifestTemplateVariables struct {
+	ClusterName          string `yaml:"clusterName"`
+	PodCIDR              string `yaml:"podCIDR"`
+	ServiceCIDR          string `yaml:"serviceCIDR"`
+	ControlPlaneEndpoint string `yaml:"controlPlaneEndpoint"`
 }
 
 // NewK8sControlPlaneAPIServer initializes a K8sControlPlane resource.
@@ -32,8 +32,18 @@ type v1alpha1Spec struct {
 	cfg config.Provider
 }
 
+// MarshalYAML implements yaml.Marshaler.
+//
+// Secrets are redacted from the marshaled config, as it is exposed via the resource API.
 func (s *v1alpha1Spec) MarshalYAML() (interface{}, error) {
-	return encoder.NewEncoder(s.cfg).Marshal()
+	cfg, err := copyConfig(s.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Redact(config.RedactedValue)
+
+	return encoder.NewEncoder(cfg).Marshal()
 }
 
 // NewV1Alpha1 initializes a V1Alpha1 resource.
@@ -66,16 +76,11 @@ func (r *V1Alpha1) String() string {
 
 // DeepCopy implements resource.Resource.
 func (r *V1Alpha1) DeepCopy() resource.Resource {
-	b, err := r.spec.cfg.Bytes()
+	c, err := copyConfig(r.spec.cfg)
 	if err != nil {
 		panic(err) // TODO: DeepCopy() should support returning errors? or config should implement DeeCopy without errors?
 	}
 
-	c, err := configloader.NewFromBytes(b)
-	if err != nil {
-		panic(err)
-	}
-
 	return &V1Alpha1{
 		md: r.md,
 		spec: &v1alpha1Spec{
@@ -97,3 +102,12 @@ func (r *V1Alpha1) ResourceDefinition() core.ResourceDefinitionSpec {
 func (r *V1Alpha1) Config() config.Provider {
 	return r.spec.cfg
 }
+
+func copyConfig(cfg config.Provider) (config.Provider, error) {
+	b, err := cfg.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return configloader.NewFromBytes(b)
+}
@@ -28,6 +28,7 @@ type Manifest struct {
 
 type manifestSpec struct {
 	Items []*unstructured.Unstructured
+	Error string
 }
 
 func (spec *manifestSpec) MarshalYAML() (interface{}, error) {
@@ -37,6 +38,13 @@ func (spec *manifestSpec) MarshalYAML() (interface{}, error) {
 		result = append(result, obj.Object)
 	}
 
+	if spec.Error != "" {
+		return map[string]interface{}{
+			"error": spec.Error,
+			"items": result,
+		}, nil
+	}
+
 	return result, nil
 }
 
@@ -70,6 +78,7 @@ func (r *Manifest) String() string {
 func (r *Manifest) DeepCopy() resource.Resource {
 	spec := &manifestSpec{
 		Items: make([]*unstructured.Unstructured, len(r.spec.Items)),
+		Error: r.spec.Error,
 	}
 
 	for i := range r.spec.Items {
@@ -92,7 +101,11 @@ func (r *Manifest) ResourceDefinition() core.ResourceDefinitionSpec {
 }
 
 // SetYAML parses manifest from YAML.
+//
+// SetYAML replaces any previously set objects and clears the error.
 func (r *Manifest) SetYAML(yamlBytes []byte) error {
+	var items []*unstructured.Unstructured
+
 	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(yamlBytes)))
 
 	for {
@@ -122,9 +135,12 @@ func (r *Manifest) SetYAML(yamlBytes []byte) error {
 			return fmt.Errorf("error loading JSON manifest into unstructured: %w", err)
 		}
 
-		r.spec.Items = append(r.spec.Items, obj)
+		items = append(items, obj)
 	}
 
+	r.spec.Items = items
+	r.spec.Error = ""
+
 	return nil
 }
 
@@ -132,3 +148,15 @@ func (r *Manifest) SetYAML(yamlBytes []byte) error {
 func (r *Manifest) Objects() []*unstructured.Unstructured {
 	return r.spec.Items
 }
+
+// SetFetchError records the error which happened while fetching or rendering the manifest.
+//
+// Previously set objects are kept as is.
+func (r *Manifest) SetFetchError(err string) {
+	r.spec.Error = err
+}
+
+// FetchError returns the error which happened while fetching or rendering the manifest.
+func (r *Manifest) FetchError() string {
+	return r.spec.Error
+}
@@ -25,9 +25,30 @@ type ManifestStatus struct {
 
 // ManifestStatusSpec describes manifest application status.
 type ManifestStatusSpec struct {
-	ManifestsApplied []string `yaml:"manifestsApplied"`
+	ManifestsApplied []string               `yaml:"manifestsApplied"`
+	Objects          []ManifestObjectStatus `yaml:"objects,omitempty"`
 }
 
+// ManifestObjectStatus describes the result of applying a single Kubernetes object.
+type ManifestObjectStatus struct {
+	// Manifest is the ID of the manifest the object comes from, empty for pruned objects.
+	Manifest string `yaml:"manifest,omitempty"`
+	// Object is the object reference in the form of group/version/kind/namespace/name.
+	Object string         `yaml:"object"`
+	Action ManifestAction `yaml:"action"`
+	Error  string         `yaml:"error,omitempty"`
+}
+
+// ManifestAction is the action taken for the Kubernetes object.
+type ManifestAction string
+
+// Manifest actions.
+const (
+	ManifestActionApplied ManifestAction = "applied"
+	ManifestActionPruned  ManifestAction = "pruned"
+	ManifestActionFailed  ManifestAction = "failed"
+)
+
 // NewManifestStatus initializes an empty ManifestStatus resource.
 func NewManifestStatus(namespace resource.Namespace) *ManifestStatus {
 	r := &ManifestStatus{
@@ -57,8 +78,11 @@ func (r *ManifestStatus) String() string {
 // DeepCopy implements resource.Resource.
 func (r *ManifestStatus) DeepCopy() resource.Resource {
 	return &ManifestStatus{
-		md:   r.md,
-		spec: r.spec,
+		md: r.md,
+		spec: ManifestStatusSpec{
+			ManifestsApplied: append([]string(nil), r.spec.ManifestsApplied...),
+			Objects:          append([]ManifestObjectStatus(nil), r.spec.Objects...),
+		},
 	}
 }
 
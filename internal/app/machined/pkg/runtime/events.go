@@ -37,6 +37,8 @@ type WatchOptions struct {
 	TailID xid.ID
 	// Start at timestamp Now() - TailDuration.
 	TailDuration time.Duration
+	// Stop at the first event with timestamp > Until.
+	Until time.Time
 }
 
 // WatchOptionFunc defines the options for the watcher.
@@ -70,6 +72,18 @@ func WithTailID(id xid.ID) WatchOptionFunc {
 	}
 }
 
+// WithUntil sets up Watcher to stop before the first event with timestamp > until.
+//
+// Watch channel is closed on the first event after until, or once the events published before until are consumed
+// and until is in the past, so buffered events are always filtered by the event timestamp.
+func WithUntil(until time.Time) WatchOptionFunc {
+	return func(opts *WatchOptions) error {
+		opts.Until = until
+
+		return nil
+	}
+}
+
 // WithTailDuration sets up Watcher to return events with timestamp >= (now - tailDuration).
 func WithTailDuration(dur time.Duration) WatchOptionFunc {
 	return func(opts *WatchOptions) error {
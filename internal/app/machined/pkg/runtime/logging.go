@@ -4,17 +4,26 @@
 
 package runtime
 
-import "io"
+import (
+	"io"
+	"time"
+)
 
 // LoggingManager provides unified interface to publish and consume logs.
 type LoggingManager interface {
 	ServiceLog(service string) LogHandler
 }
 
+// LogTimestampLayout is the layout of the timestamps prepended to the log lines with WithTimestamps.
+const LogTimestampLayout = time.RFC3339Nano
+
 // LogOptions for LogHandler.Reader.
 type LogOptions struct {
-	Follow    bool
-	TailLines *int
+	Follow     bool
+	TailLines  *int
+	Since      time.Time
+	Until      time.Time
+	Timestamps bool
 }
 
 // LogOption provides functional options for LogHandler.Reader.
@@ -38,6 +47,34 @@ func WithTailLines(lines int) LogOption {
 	}
 }
 
+// WithLogSince skips the log lines written before the timestamp.
+func WithLogSince(since time.Time) LogOption {
+	return func(o *LogOptions) error {
+		o.Since = since
+
+		return nil
+	}
+}
+
+// WithLogUntil skips the log lines written after the timestamp.
+func WithLogUntil(until time.Time) LogOption {
+	return func(o *LogOptions) error {
+		o.Until = until
+
+		return nil
+	}
+}
+
+// WithTimestamps prepends each log line with the time it was written at
+// formatted with LogTimestampLayout and a space.
+func WithTimestamps() LogOption {
+	return func(o *LogOptions) error {
+		o.Timestamps = true
+
+		return nil
+	}
+}
+
 // LogHandler provides interface to access particular log file.
 type LogHandler interface {
 	Writer() (io.WriteCloser, error)
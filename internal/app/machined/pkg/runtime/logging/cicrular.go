@@ -42,7 +42,18 @@ func (manager *CircularBufferLoggingManager) ServiceLog(id string) runtime.LogHa
 	}
 }
 
-func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (*circular.Buffer, error) {
+// circularLog is a circular buffer with the index of the line timestamps.
+type circularLog struct {
+	buf *circular.Buffer
+	idx *lineIndex
+}
+
+// Write implements io.Writer.
+func (log *circularLog) Write(p []byte) (int, error) {
+	return log.idx.write(log.buf, p)
+}
+
+func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (*circularLog, error) {
 	buf, ok := manager.buffers.Load(id)
 	if !ok {
 		if !create {
@@ -57,17 +68,20 @@ func (manager *CircularBufferLoggingManager) getBuffer(id string, create bool) (
 			return nil, err // only configuration issue might raise error
 		}
 
-		buf, _ = manager.buffers.LoadOrStore(id, b)
+		buf, _ = manager.buffers.LoadOrStore(id, &circularLog{
+			buf: b,
+			idx: &lineIndex{window: MaxCapacity},
+		})
 	}
 
-	return buf.(*circular.Buffer), nil
+	return buf.(*circularLog), nil
 }
 
 type circularHandler struct {
 	manager *CircularBufferLoggingManager
 	id      string
 
-	buf *circular.Buffer
+	buf *circularLog
 }
 
 type nopCloser struct {
@@ -123,14 +137,33 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 		io.Seeker
 	}
 
+	// reader is created under the index lock, so that the end of the reader
+	// matches the end of the index
+	handler.buf.idx.mu.Lock()
+
 	if opt.Follow {
-		r = handler.buf.GetStreamingReader()
+		r = handler.buf.buf.GetStreamingReader()
 	} else {
-		r = handler.buf.GetReader()
+		r = handler.buf.buf.GetReader()
+	}
+
+	end, err := r.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = r.Seek(0, io.SeekStart)
+	}
+
+	startOff := handler.buf.idx.off - end
+
+	handler.buf.idx.mu.Unlock()
+
+	if err != nil {
+		r.Close() //nolint: errcheck
+
+		return nil, fmt.Errorf("error seeking log: %w", err)
 	}
 
 	if opt.TailLines != nil {
-		err := tail.SeekLines(r, *opt.TailLines)
+		err = tail.SeekLines(r, *opt.TailLines)
 		if err != nil {
 			r.Close() //nolint: errcheck
 
@@ -138,5 +171,16 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 		}
 	}
 
-	return r, nil
+	if opt.Since.IsZero() && opt.Until.IsZero() && !opt.Timestamps {
+		return r, nil
+	}
+
+	pos, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		r.Close() //nolint: errcheck
+
+		return nil, fmt.Errorf("error seeking log: %w", err)
+	}
+
+	return newTimestampReader(r, handler.buf.idx.timestamps(startOff+pos), &opt), nil
 }
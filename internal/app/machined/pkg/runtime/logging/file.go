@@ -5,43 +5,119 @@
 package logging
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/follow"
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/tail"
 )
 
 // FileLoggingManager implements simple logging to files.
+//
+// Log files are rotated when they grow over the maximum file size or get older
+// than the maximum age, rotated logs are compressed. Rotated logs are removed
+// when they get older than the maximum age, or the oldest ones are removed
+// when the logs in the directory grow over the total size limit.
+//
+// Zero limits disable the rotation and retention.
+//
+// Reading logs with timestamps or filtered by time requires the line timestamps
+// to be stored in the log files, see WithLineTimestamps.
 type FileLoggingManager struct {
 	logDirectory string
+
+	maxFileSize  int64
+	maxAge       time.Duration
+	maxTotalSize int64
+
+	lineTimestamps bool
+
+	// rotateMu serializes compressions and cleanups of the rotated logs
+	rotateMu sync.Mutex
+}
+
+// FileLoggingOption configures FileLoggingManager.
+type FileLoggingOption func(manager *FileLoggingManager)
+
+// WithMaxFileSize sets the size of the log file which triggers the log rotation.
+func WithMaxFileSize(size int64) FileLoggingOption {
+	return func(manager *FileLoggingManager) {
+		manager.maxFileSize = size
+	}
+}
+
+// WithMaxAge sets the age of the log file which triggers the log rotation.
+//
+// Rotated logs older than the limit are removed.
+func WithMaxAge(age time.Duration) FileLoggingOption {
+	return func(manager *FileLoggingManager) {
+		manager.maxAge = age
+	}
+}
+
+// WithMaxTotalSize sets the total size of the logs in the log directory.
+func WithMaxTotalSize(size int64) FileLoggingOption {
+	return func(manager *FileLoggingManager) {
+		manager.maxTotalSize = size
+	}
+}
+
+// WithLineTimestamps stores the time each line was written at in the log files.
+//
+// Timestamps are stripped from the lines when the logs are read, unless requested
+// with runtime.WithTimestamps.
+func WithLineTimestamps() FileLoggingOption {
+	return func(manager *FileLoggingManager) {
+		manager.lineTimestamps = true
+	}
+}
+
+// WithLoggingConfig sets the rotation and retention limits from the machine config.
+func WithLoggingConfig(cfg config.Logging) FileLoggingOption {
+	return func(manager *FileLoggingManager) {
+		manager.maxFileSize = cfg.MaxFileSize()
+		manager.maxAge = cfg.MaxAge()
+		manager.maxTotalSize = cfg.MaxTotalSize()
+	}
 }
 
 // NewFileLoggingManager initializes new FileLoggingManager.
-func NewFileLoggingManager(logDirectory string) *FileLoggingManager {
-	return &FileLoggingManager{
+func NewFileLoggingManager(logDirectory string, options ...FileLoggingOption) *FileLoggingManager {
+	manager := &FileLoggingManager{
 		logDirectory: logDirectory,
 	}
+
+	for _, o := range options {
+		o(manager)
+	}
+
+	return manager
 }
 
 // ServiceLog implements runtime.LoggingManager interface.
 func (manager *FileLoggingManager) ServiceLog(id string) runtime.LogHandler {
 	return &fileLogHandler{
-		logDirectory: manager.logDirectory,
-		id:           id,
+		manager: manager,
+		id:      id,
 	}
 }
 
 type fileLogHandler struct {
 	path string
 
-	logDirectory string
-	id           string
+	manager *FileLoggingManager
+	id      string
 }
 
 func (handler *fileLogHandler) buildPath() error {
@@ -49,7 +125,7 @@ func (handler *fileLogHandler) buildPath() error {
 		return fmt.Errorf("service ID is invalid")
 	}
 
-	handler.path = filepath.Join(handler.logDirectory, handler.id+".log")
+	handler.path = filepath.Join(handler.manager.logDirectory, handler.id+".log")
 
 	return nil
 }
@@ -60,10 +136,24 @@ func (handler *fileLogHandler) Writer() (io.WriteCloser, error) {
 		return nil, err
 	}
 
-	return os.OpenFile(handler.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o666)
+	w := &fileLogWriter{
+		manager: handler.manager,
+		path:    handler.path,
+	}
+
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
 }
 
 // Reader implements runtime.LogHandler interface.
+//
+// If the current log file contains less lines than requested with TailLines,
+// the rest of the lines is read from the rotated logs.
+//
+//nolint: gocyclo
 func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser, error) {
 	var opt runtime.LogOptions
 
@@ -73,6 +163,10 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		}
 	}
 
+	if !handler.manager.lineTimestamps && (!opt.Since.IsZero() || !opt.Until.IsZero() || opt.Timestamps) {
+		return nil, fmt.Errorf("log timestamps are not enabled for file logs")
+	}
+
 	if err := handler.buildPath(); err != nil {
 		return nil, err
 	}
@@ -82,8 +176,10 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		return nil, err
 	}
 
+	var rotated []byte
+
 	if opt.TailLines != nil {
-		err = tail.SeekLines(f, *opt.TailLines)
+		rotated, err = handler.tail(f, *opt.TailLines)
 		if err != nil {
 			f.Close() //nolint: errcheck
 
@@ -91,9 +187,283 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		}
 	}
 
+	var r io.ReadCloser = f
+
 	if opt.Follow {
-		return follow.NewReader(context.Background(), f), nil
+		r = &fileFollowReader{
+			r:    follow.NewReader(context.Background(), f),
+			path: handler.path,
+		}
+	}
+
+	if len(rotated) > 0 {
+		r = &multiReadCloser{
+			Reader: io.MultiReader(bytes.NewReader(rotated), r),
+			Closer: r,
+		}
+	}
+
+	if handler.manager.lineTimestamps {
+		r = newTimestampReader(r, parseLineTimestamp, &opt)
+	}
+
+	return r, nil
+}
+
+// parseLineTimestamp splits off the timestamp stored in the log file before the line.
+//
+// Lines without the timestamp (written before the timestamps were enabled) are returned
+// as is, with zero time.
+func parseLineTimestamp(line []byte) (time.Time, []byte) {
+	i := bytes.IndexByte(line, ' ')
+	if i < 0 {
+		return time.Time{}, line
+	}
+
+	ts, err := time.Parse(runtime.LogTimestampLayout, string(line[:i]))
+	if err != nil {
+		return time.Time{}, line
+	}
+
+	return ts, line[i+1:]
+}
+
+// tail seeks f to the requested number of lines from the end.
+//
+// If f contains less lines, f is positioned at the start, and the rest of the lines
+// is returned from the rotated logs.
+func (handler *fileLogHandler) tail(f *os.File, lines int) ([]byte, error) {
+	if err := tail.SeekLines(f, lines); err != nil {
+		return nil, err
+	}
+
+	pos, err := f.Seek(0, io.SeekCurrent)
+	if err != nil || pos > 0 {
+		return nil, err
+	}
+
+	current, err := countLines(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	if current >= lines {
+		return nil, nil
+	}
+
+	return handler.manager.rotatedTail(handler.path, lines-current)
+}
+
+type multiReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// fileFollowReader follows the log file across the log rotations.
+type fileFollowReader struct {
+	r    *follow.Reader
+	path string
+}
+
+// Read implements io.Reader.
+func (r *fileFollowReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.r.Read(p)
+		if !errors.Is(err, follow.ErrFileRemoved) {
+			return n, err
+		}
+
+		r.r.Close() //nolint: errcheck
+
+		// log was rotated, continue with the new log file, which might not be created yet
+		f, err := os.OpenFile(r.path, os.O_CREATE|os.O_RDONLY, 0o666)
+		if err != nil {
+			return n, err
+		}
+
+		r.r = follow.NewReader(context.Background(), f)
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Close implements io.Closer.
+func (r *fileFollowReader) Close() error {
+	return r.r.Close()
+}
+
+// fileLogWriter appends to the log file rotating it when the limits are reached.
+type fileLogWriter struct {
+	manager *FileLoggingManager
+	path    string
+
+	mu      sync.Mutex
+	f       *os.File
+	size    int64
+	started time.Time
+	midLine bool
+
+	compressWg sync.WaitGroup
+}
+
+func (w *fileLogWriter) open() error {
+	// file is opened for reading as well to check whether the log ends with an incomplete line
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_RDWR, 0o666)
+	if err != nil {
+		return err
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint: errcheck
+
+		return err
 	}
 
-	return f, nil
+	w.f = f
+	w.size = st.Size()
+	w.started = time.Now()
+	w.midLine = false
+
+	if w.manager.lineTimestamps && w.size > 0 {
+		var last [1]byte
+
+		if _, err = f.ReadAt(last[:], w.size-1); err != nil {
+			f.Close() //nolint: errcheck
+
+			return err
+		}
+
+		w.midLine = last[0] != '\n'
+	}
+
+	return nil
+}
+
+// Write implements io.Writer.
+func (w *fileLogWriter) Write(p []byte) (int, error) {
+	if w.manager.lineTimestamps {
+		return w.writeStamped(time.Now(), p)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+
+	if err != nil {
+		return n, err
+	}
+
+	if w.manager.needsRotation(w.size, w.started) {
+		if err = w.rotate(); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// writeStamped writes p to the log prepending each new line with ts.
+func (w *fileLogWriter) writeStamped(ts time.Time, p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n, err := w.f.Write(w.stampLines(ts, p))
+	w.size += int64(n)
+
+	if err != nil {
+		// written size includes the timestamps, so it can't be mapped back to p
+		return 0, err
+	}
+
+	// rotate only at the line boundary, so that each line in the rotated log keeps its timestamp
+	if !w.midLine && w.manager.needsRotation(w.size, w.started) {
+		if err = w.rotate(); err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// stampLines prepends each new line in p with ts.
+func (w *fileLogWriter) stampLines(ts time.Time, p []byte) []byte {
+	stamp := ts.Format(runtime.LogTimestampLayout) + " "
+	buf := make([]byte, 0, len(p)+len(stamp))
+
+	for len(p) > 0 {
+		if !w.midLine {
+			buf = append(buf, stamp...)
+		}
+
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			buf = append(buf, p...)
+			w.midLine = true
+
+			break
+		}
+
+		buf = append(buf, p[:i+1]...)
+		p = p[i+1:]
+		w.midLine = false
+	}
+
+	return buf
+}
+
+func (w *fileLogWriter) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+
+	rotatedPath, renameErr := w.manager.rotate(w.path)
+
+	if err := w.open(); err != nil {
+		return err
+	}
+
+	if renameErr != nil {
+		// keep writing to the current file, rotation is retried once the file grows over the limit again
+		w.size = 0
+
+		// errors are logged in the background, as the log output might be written to this log
+		go log.Printf("error rotating log %q: %s", w.path, renameErr)
+
+		return nil
+	}
+
+	// compression and cleanup might take a while, so they run in the background not to block the writes
+	w.compressWg.Add(1)
+
+	go func() {
+		defer w.compressWg.Done()
+
+		if err := w.manager.compress(rotatedPath); err != nil {
+			log.Printf("error compressing rotated log %q: %s", rotatedPath, err)
+		}
+	}()
+
+	return nil
+}
+
+// Close implements io.Closer.
+//
+// Close waits for the rotated logs to be compressed.
+func (w *fileLogWriter) Close() error {
+	w.mu.Lock()
+	err := w.f.Close()
+	w.mu.Unlock()
+
+	w.compressWg.Wait()
+
+	return err
 }
@@ -0,0 +1,267 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+)
+
+func readLines(t *testing.T, handler runtime.LogHandler, opts ...runtime.LogOption) []string {
+	r, err := handler.Reader(opts...)
+	require.NoError(t, err)
+
+	defer r.Close() //nolint: errcheck
+
+	contents, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+
+	return strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n")
+}
+
+func TestFileLogRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	manager := logging.NewFileLoggingManager(dir, logging.WithMaxFileSize(100), logging.WithMaxTotalSize(10000))
+
+	handler := manager.ServiceLog("service")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	// each line is 10 bytes, so the log is rotated every 10 lines
+	for i := 0; i < 95; i++ {
+		_, err = fmt.Fprintf(w, "line %04d\n", i)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, w.Close())
+
+	rotated, err := filepath.Glob(filepath.Join(dir, "service.log.*.gz"))
+	require.NoError(t, err)
+	assert.Len(t, rotated, 9)
+
+	assert.Equal(t, []string{"line 0090", "line 0091", "line 0092", "line 0093", "line 0094"}, readLines(t, handler))
+	assert.Equal(t, []string{"line 0093", "line 0094"}, readLines(t, handler, runtime.WithTailLines(2)))
+
+	lines := readLines(t, handler, runtime.WithTailLines(27))
+	require.Len(t, lines, 27)
+	assert.Equal(t, "line 0068", lines[0])
+	assert.Equal(t, "line 0094", lines[26])
+
+	// more lines than available
+	lines = readLines(t, handler, runtime.WithTailLines(1000))
+	require.Len(t, lines, 95)
+	assert.Equal(t, "line 0000", lines[0])
+}
+
+func TestFileLogTotalSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	// compressed logs are ~200 bytes each
+	manager := logging.NewFileLoggingManager(dir, logging.WithMaxFileSize(1000), logging.WithMaxTotalSize(1000))
+
+	w, err := manager.ServiceLog("service").Writer()
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		_, err = fmt.Fprintf(w, "line %04d\n", i)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, w.Close())
+
+	rotated, err := filepath.Glob(filepath.Join(dir, "service.log.*"))
+	require.NoError(t, err)
+
+	var totalSize int64
+
+	for _, path := range rotated {
+		st, err := os.Stat(path)
+		require.NoError(t, err)
+
+		totalSize += st.Size()
+	}
+
+	assert.NotEmpty(t, rotated)
+	assert.Less(t, len(rotated), 10)
+	assert.LessOrEqual(t, totalSize, int64(1000))
+}
+
+func TestFileLogConfigRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	cfg, err := configloader.NewFromBytes([]byte(`version: v1alpha1
+machine:
+  type: join
+  logging:
+    maxFileSize: 1000
+    maxAge: 1h
+    maxTotalSize: 1000
+`))
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 1000, cfg.Machine().Logging().MaxFileSize())
+	assert.Equal(t, time.Hour, cfg.Machine().Logging().MaxAge())
+	assert.EqualValues(t, 1000, cfg.Machine().Logging().MaxTotalSize())
+
+	manager := logging.NewFileLoggingManager(dir, logging.WithLoggingConfig(cfg.Machine().Logging()))
+
+	w, err := manager.ServiceLog("service").Writer()
+	require.NoError(t, err)
+
+	// each line is 10 bytes, so the log is rotated every 100 lines,
+	// compressed logs are ~200 bytes each, so the oldest ones are removed
+	for i := 0; i < 1000; i++ {
+		_, err = fmt.Fprintf(w, "line %04d\n", i)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, w.Close())
+
+	rotated, err := filepath.Glob(filepath.Join(dir, "service.log.*.gz"))
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, rotated)
+	assert.Less(t, len(rotated), 10)
+
+	// current log is empty after the last rotation
+	st, err := os.Stat(filepath.Join(dir, "service.log"))
+	require.NoError(t, err)
+	assert.Zero(t, st.Size())
+}
+
+func TestFileLogFollowRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	manager := logging.NewFileLoggingManager(dir, logging.WithMaxFileSize(100))
+
+	handler := manager.ServiceLog("service")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	defer w.Close() //nolint: errcheck
+
+	r, err := handler.Reader(runtime.WithFollow())
+	require.NoError(t, err)
+
+	defer r.Close() //nolint: errcheck
+
+	go func() {
+		for i := 0; i < 25; i++ {
+			fmt.Fprintf(w, "line %04d\n", i) //nolint: errcheck
+
+			time.Sleep(5 * time.Millisecond)
+		}
+	}()
+
+	contents := make([]byte, 250)
+
+	_, err = io.ReadFull(r, contents)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(string(contents), "line 0000\n"))
+	assert.True(t, strings.HasSuffix(string(contents), "line 0024\n"))
+}
+
+func TestFileLogTimestamps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	manager := logging.NewFileLoggingManager(dir, logging.WithMaxFileSize(100), logging.WithLineTimestamps())
+
+	handler := manager.ServiceLog("service")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	defer w.Close() //nolint: errcheck
+
+	// lines are split across the writes
+	_, err = w.Write([]byte("line 0000\nline "))
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("0001\n"))
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	middle := time.Now()
+
+	time.Sleep(10 * time.Millisecond)
+
+	// rotates the log, timestamps are preserved in the rotated log
+	for i := 2; i < 20; i++ {
+		_, err = fmt.Fprintf(w, "line %04d\n", i)
+		require.NoError(t, err)
+	}
+
+	// rotated logs might still be being compressed
+	rotated, err := filepath.Glob(filepath.Join(dir, "service.log.*"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, rotated)
+
+	// timestamps are stripped by default
+	assert.Equal(t, []string{"line 0018", "line 0019"}, readLines(t, handler, runtime.WithTailLines(2)))
+
+	assert.Equal(t, []string{"line 0000", "line 0001"}, readLines(t, handler, runtime.WithTailLines(100), runtime.WithLogUntil(middle)))
+	assert.Len(t, readLines(t, handler, runtime.WithTailLines(100), runtime.WithLogSince(middle)), 18)
+
+	lines := readLines(t, handler, runtime.WithTailLines(1), runtime.WithTimestamps())
+	require.Len(t, lines, 1)
+
+	parts := strings.SplitN(lines[0], " ", 2)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "line 0019", parts[1])
+
+	ts, err := time.Parse(runtime.LogTimestampLayout, parts[0])
+	require.NoError(t, err)
+	assert.True(t, ts.After(middle))
+}
+
+func TestFileLogTimestampsDisabled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	handler := logging.NewFileLoggingManager(dir).ServiceLog("service")
+
+	w, err := handler.Writer()
+	require.NoError(t, err)
+
+	require.NoError(t, w.Close())
+
+	_, err = handler.Reader(runtime.WithTimestamps())
+	assert.EqualError(t, err, "log timestamps are not enabled for file logs")
+}
@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+// PersistentLoggingManager keeps the service logs in memory until the log files
+// become available.
+//
+// Once the logs are persisted with Persist, the logs are written both to memory and to
+// the log files, and the logs are read from the log files.
+type PersistentLoggingManager struct {
+	memory *CircularBufferLoggingManager
+
+	mu      sync.RWMutex
+	files   *FileLoggingManager
+	ids     map[string]struct{}
+	writers map[*persistentLogWriter]struct{}
+}
+
+// NewPersistentLoggingManager initializes new PersistentLoggingManager.
+func NewPersistentLoggingManager() *PersistentLoggingManager {
+	return &PersistentLoggingManager{
+		memory:  NewCircularBufferLoggingManager(),
+		ids:     map[string]struct{}{},
+		writers: map[*persistentLogWriter]struct{}{},
+	}
+}
+
+// Persist starts writing the logs to the log files.
+//
+// Logs written so far are copied from memory to the log files keeping the time the lines
+// were written at, so files should store the line timestamps (see WithLineTimestamps).
+func (manager *PersistentLoggingManager) Persist(files *FileLoggingManager) error {
+	if !files.lineTimestamps {
+		return fmt.Errorf("log files should store line timestamps")
+	}
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if manager.files != nil {
+		return fmt.Errorf("logs are already persisted")
+	}
+
+	for id := range manager.ids {
+		if err := manager.copyLog(files, id); err != nil {
+			return fmt.Errorf("error persisting log %q: %w", id, err)
+		}
+	}
+
+	manager.files = files
+
+	return nil
+}
+
+// Unpersist stops writing the logs to the log files and closes the log files,
+// so that the partition the log files are stored on can be unmounted.
+//
+// Logs are still written to memory, and read from memory.
+func (manager *PersistentLoggingManager) Unpersist() {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	manager.files = nil
+
+	for w := range manager.writers {
+		w.closeFile()
+	}
+}
+
+func (manager *PersistentLoggingManager) copyLog(files *FileLoggingManager, id string) error {
+	r, err := manager.memory.ServiceLog(id).Reader(runtime.WithTimestamps())
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer r.Close()
+
+	w, err := files.ServiceLog(id).Writer()
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer w.Close()
+
+	fw := w.(*fileLogWriter)
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadBytes('\n')
+
+		if len(line) > 0 {
+			ts, data := parseLineTimestamp(line)
+
+			if _, writeErr := fw.writeStamped(ts, data); writeErr != nil {
+				return writeErr
+			}
+		}
+
+		if err != nil {
+			if err == io.EOF {
+				return w.Close()
+			}
+
+			return err
+		}
+	}
+}
+
+// ServiceLog implements runtime.LoggingManager interface.
+func (manager *PersistentLoggingManager) ServiceLog(id string) runtime.LogHandler {
+	return &persistentLogHandler{
+		manager: manager,
+		id:      id,
+	}
+}
+
+type persistentLogHandler struct {
+	manager *PersistentLoggingManager
+	id      string
+}
+
+// Writer implements runtime.LogHandler interface.
+func (handler *persistentLogHandler) Writer() (io.WriteCloser, error) {
+	handler.manager.mu.Lock()
+	defer handler.manager.mu.Unlock()
+
+	memory, err := handler.manager.memory.ServiceLog(handler.id).Writer()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &persistentLogWriter{
+		manager: handler.manager,
+		id:      handler.id,
+		memory:  memory,
+	}
+
+	handler.manager.ids[handler.id] = struct{}{}
+	handler.manager.writers[w] = struct{}{}
+
+	return w, nil
+}
+
+// Reader implements runtime.LogHandler interface.
+func (handler *persistentLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser, error) {
+	handler.manager.mu.RLock()
+	files := handler.manager.files
+	handler.manager.mu.RUnlock()
+
+	if files == nil {
+		return handler.manager.memory.ServiceLog(handler.id).Reader(opts...)
+	}
+
+	return files.ServiceLog(handler.id).Reader(opts...)
+}
+
+// persistentLogWriter writes to memory, and to the log file once the logs are persisted.
+type persistentLogWriter struct {
+	manager *PersistentLoggingManager
+	id      string
+	memory  io.WriteCloser
+
+	mu   sync.Mutex
+	file io.WriteCloser
+}
+
+// Write implements io.Writer.
+//
+// Errors writing to the log file are ignored, as the log is still kept in memory.
+func (w *persistentLogWriter) Write(p []byte) (int, error) {
+	w.manager.mu.RLock()
+	defer w.manager.mu.RUnlock()
+
+	n, err := w.memory.Write(p)
+	if err != nil || w.manager.files == nil {
+		return n, err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		// opening the log file is retried on the next write
+		w.file, _ = w.manager.files.ServiceLog(w.id).Writer() //nolint: errcheck
+	}
+
+	if w.file != nil {
+		w.file.Write(p) //nolint: errcheck
+	}
+
+	return n, nil
+}
+
+func (w *persistentLogWriter) closeFile() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file != nil {
+		w.file.Close() //nolint: errcheck
+
+		w.file = nil
+	}
+}
+
+// Close implements io.Closer.
+func (w *persistentLogWriter) Close() error {
+	w.manager.mu.Lock()
+	delete(w.manager.writers, w)
+	w.manager.mu.Unlock()
+
+	w.closeFile()
+
+	return w.memory.Close()
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+func TestPersistentLogging(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	manager := logging.NewPersistentLoggingManager()
+
+	w, err := manager.ServiceLog("service").Writer()
+	require.NoError(t, err)
+
+	defer w.Close() //nolint: errcheck
+
+	// line is split across the persistence
+	_, err = w.Write([]byte("in memory\npartial "))
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	persisted := time.Now()
+
+	assert.Error(t, manager.Persist(logging.NewFileLoggingManager(dir)))
+	require.NoError(t, manager.Persist(logging.NewFileLoggingManager(dir, logging.WithLineTimestamps())))
+	assert.Error(t, manager.Persist(logging.NewFileLoggingManager(dir, logging.WithLineTimestamps())))
+
+	_, err = w.Write([]byte("line\n"))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = fmt.Fprintf(w, "line %d\n", i)
+		require.NoError(t, err)
+	}
+
+	expected := []string{"in memory", "partial line", "line 0", "line 1", "line 2"}
+
+	// log file keeps the time the lines were written at
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "service.log"))
+	require.NoError(t, err)
+
+	fileLines := strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n")
+	require.Len(t, fileLines, len(expected))
+
+	for i, line := range fileLines {
+		parts := strings.SplitN(line, " ", 2)
+		require.Len(t, parts, 2)
+
+		assert.Equal(t, expected[i], parts[1])
+
+		ts, err := time.Parse(runtime.LogTimestampLayout, parts[0])
+		require.NoError(t, err)
+
+		assert.Equal(t, i >= 2, ts.After(persisted), "line %q", line)
+	}
+
+	// logs are read from the file
+	assert.Equal(t, expected, readLines(t, manager.ServiceLog("service")))
+	assert.Equal(t, []string{"line 1", "line 2"}, readLines(t, manager.ServiceLog("service"), runtime.WithTailLines(2)))
+	assert.Equal(t, expected[:2], readLines(t, manager.ServiceLog("service"), runtime.WithLogUntil(persisted)))
+
+	manager.Unpersist()
+
+	_, err = w.Write([]byte("after unpersist\n"))
+	require.NoError(t, err)
+
+	// log file is not written to anymore, logs are read from memory
+	persistedContents, err := ioutil.ReadFile(filepath.Join(dir, "service.log"))
+	require.NoError(t, err)
+	assert.Equal(t, contents, persistedContents)
+
+	assert.Equal(t, append(expected, "after unpersist"), readLines(t, manager.ServiceLog("service")))
+}
+
+func TestPersistentLoggingNotRegistered(t *testing.T) {
+	manager := logging.NewPersistentLoggingManager()
+
+	_, err := manager.ServiceLog("service").Reader()
+	assert.Error(t, err)
+}
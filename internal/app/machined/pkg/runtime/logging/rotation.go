@@ -0,0 +1,299 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/talos/pkg/tail"
+)
+
+// rotatedTimestampLayout is the layout of the timestamp in the rotated log name.
+//
+// Rotated log names sort in the rotation order.
+const rotatedTimestampLayout = "20060102T150405.000000000"
+
+const compressedSuffix = ".gz"
+
+// needsRotation checks whether the log file of the given size opened at started should be rotated.
+func (manager *FileLoggingManager) needsRotation(size int64, started time.Time) bool {
+	if manager.maxFileSize > 0 && size >= manager.maxFileSize {
+		return true
+	}
+
+	return manager.maxAge > 0 && size > 0 && time.Since(started) >= manager.maxAge
+}
+
+// rotate renames the log file to the rotated log `<id>.log.<timestamp>`.
+//
+// Rename doesn't wait for the rotated logs being compressed, so that the writes are not blocked.
+func (manager *FileLoggingManager) rotate(path string) (string, error) {
+	rotatedPath := path + "." + time.Now().UTC().Format(rotatedTimestampLayout)
+
+	return rotatedPath, os.Rename(path, rotatedPath)
+}
+
+// compress compresses the rotated log and removes the rotated logs over the limits.
+//
+// If the compression fails, the rotated log is kept uncompressed.
+func (manager *FileLoggingManager) compress(rotatedPath string) error {
+	manager.rotateMu.Lock()
+	defer manager.rotateMu.Unlock()
+
+	err := compressFile(rotatedPath, rotatedPath+compressedSuffix)
+
+	switch {
+	case err == nil:
+		err = os.Remove(rotatedPath)
+	case os.IsNotExist(err):
+		// removed by the cleanup before it was compressed
+		err = nil
+	}
+
+	if cleanupErr := manager.cleanup(); err == nil {
+		err = cleanupErr
+	}
+
+	return err
+}
+
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer in.Close()
+
+	st, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := dst + ".tmp"
+
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o666)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer os.Remove(tmp)
+
+	//nolint: errcheck
+	defer out.Close()
+
+	zw := gzip.NewWriter(out)
+
+	if _, err = io.Copy(zw, in); err != nil {
+		return err
+	}
+
+	if err = zw.Close(); err != nil {
+		return err
+	}
+
+	if err = out.Close(); err != nil {
+		return err
+	}
+
+	// keep the rotation time, as the log age is checked using it
+	if err = os.Chtimes(tmp, st.ModTime(), st.ModTime()); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, dst)
+}
+
+// cleanup removes the rotated logs which are older than the maximum age, and the
+// oldest rotated logs while the logs in the directory are over the total size limit.
+func (manager *FileLoggingManager) cleanup() error {
+	infos, err := ioutil.ReadDir(manager.logDirectory)
+	if err != nil {
+		return err
+	}
+
+	var (
+		totalSize int64
+		rotated   []os.FileInfo
+	)
+
+	for _, info := range infos {
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(info.Name(), ".log"):
+			totalSize += info.Size()
+		case isRotatedLog(info.Name()):
+			totalSize += info.Size()
+
+			rotated = append(rotated, info)
+		}
+	}
+
+	sort.Slice(rotated, func(i, j int) bool { return rotated[i].ModTime().Before(rotated[j].ModTime()) })
+
+	now := time.Now()
+
+	for _, info := range rotated {
+		expired := manager.maxAge > 0 && now.Sub(info.ModTime()) > manager.maxAge
+		overLimit := manager.maxTotalSize > 0 && totalSize > manager.maxTotalSize
+
+		if !expired && !overLimit {
+			continue
+		}
+
+		if err = os.Remove(filepath.Join(manager.logDirectory, info.Name())); err != nil {
+			return err
+		}
+
+		totalSize -= info.Size()
+	}
+
+	return nil
+}
+
+// isRotatedLog checks whether the file name is `<id>.log.<timestamp>[.gz]`.
+func isRotatedLog(name string) bool {
+	idx := strings.LastIndex(name, ".log.")
+	if idx == -1 {
+		return false
+	}
+
+	_, err := time.Parse(rotatedTimestampLayout, strings.TrimSuffix(name[idx+len(".log."):], compressedSuffix))
+
+	return err == nil
+}
+
+// rotatedLogs returns the rotated logs of the log file, oldest first.
+func rotatedLogs(path string) ([]string, error) {
+	infos, err := ioutil.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := filepath.Base(path) + "."
+
+	var rotated []string
+
+	for _, info := range infos {
+		if info.Mode().IsRegular() && strings.HasPrefix(info.Name(), prefix) && isRotatedLog(info.Name()) {
+			rotated = append(rotated, filepath.Join(filepath.Dir(path), info.Name()))
+		}
+	}
+
+	sort.Strings(rotated)
+
+	return rotated, nil
+}
+
+// rotatedTail returns the last lines of the rotated logs of the log file.
+func (manager *FileLoggingManager) rotatedTail(path string, lines int) ([]byte, error) {
+	// rotated logs are not consistent while being compressed
+	manager.rotateMu.Lock()
+	defer manager.rotateMu.Unlock()
+
+	rotated, err := rotatedLogs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var chunks [][]byte
+
+	for i := len(rotated) - 1; i >= 0 && lines > 0; i-- {
+		var contents []byte
+
+		contents, err = readRotatedLog(rotated[i])
+		if err != nil {
+			if os.IsNotExist(err) {
+				// removed while reading
+				continue
+			}
+
+			return nil, err
+		}
+
+		r := bytes.NewReader(contents)
+
+		if err = tail.SeekLines(r, lines); err != nil {
+			return nil, err
+		}
+
+		chunk := contents[len(contents)-r.Len():]
+
+		var n int
+
+		n, err = countLines(bytes.NewReader(chunk))
+		if err != nil {
+			return nil, err
+		}
+
+		lines -= n
+
+		chunks = append([][]byte{chunk}, chunks...)
+	}
+
+	return bytes.Join(chunks, nil), nil
+}
+
+func readRotatedLog(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer f.Close()
+
+	if !strings.HasSuffix(path, compressedSuffix) {
+		return ioutil.ReadAll(f)
+	}
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading rotated log %q: %w", path, err)
+	}
+
+	//nolint: errcheck
+	defer zr.Close()
+
+	return ioutil.ReadAll(zr)
+}
+
+// countLines counts the lines the same way tail.SeekLines does: the last line might not have '\n'.
+func countLines(r io.Reader) (int, error) {
+	br := bufio.NewReader(r)
+
+	var lines int
+
+	for {
+		line, err := br.ReadSlice('\n')
+
+		if len(line) > 0 && (err == nil || err == io.EOF) {
+			lines++
+		}
+
+		if err == io.EOF {
+			return lines, nil
+		}
+
+		if err != nil && err != bufio.ErrBufferFull {
+			return 0, err
+		}
+	}
+}
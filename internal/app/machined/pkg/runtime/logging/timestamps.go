@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+type lineIndexEntry struct {
+	off int64
+	ts  time.Time
+}
+
+// lineIndex records the time each log line was written at.
+//
+// Entries are keyed by the absolute offset of the line start in the log,
+// entries for the lines which fell out of the log window are dropped.
+type lineIndex struct {
+	mu sync.Mutex
+
+	entries []lineIndexEntry
+	off     int64
+	midLine bool
+
+	window int64
+}
+
+// write passes p to w recording the start of each new line in p.
+func (idx *lineIndex) write(w io.Writer, p []byte) (int, error) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	now := time.Now()
+
+	for i := 0; i < len(p); {
+		if !idx.midLine {
+			idx.entries = append(idx.entries, lineIndexEntry{off: idx.off + int64(i), ts: now})
+			idx.midLine = true
+		}
+
+		j := bytes.IndexByte(p[i:], '\n')
+		if j < 0 {
+			break
+		}
+
+		i += j + 1
+		idx.midLine = false
+	}
+
+	n, err := w.Write(p)
+
+	idx.off += int64(n)
+	idx.trim()
+
+	return n, err
+}
+
+func (idx *lineIndex) trim() {
+	if idx.window <= 0 {
+		return
+	}
+
+	limit := idx.off - idx.window
+
+	// keep the last line starting before the limit, as it might be partially in the window
+	cut := sort.Search(len(idx.entries), func(i int) bool { return idx.entries[i].off > limit }) - 1
+
+	// amortize the copying
+	if cut > 0 && cut >= len(idx.entries)/2 {
+		idx.entries = append(idx.entries[:0], idx.entries[cut:]...)
+	}
+}
+
+// timestamps returns the func looking up the times the lines were written at,
+// off is the absolute offset of the first line passed to the func.
+func (idx *lineIndex) timestamps(off int64) lineTimestampFunc {
+	return func(line []byte) (time.Time, []byte) {
+		ts := idx.lookup(off)
+		off += int64(len(line))
+
+		return ts, line
+	}
+}
+
+// lookup returns the time the line containing absolute offset off was written at.
+func (idx *lineIndex) lookup(off int64) time.Time {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	i := sort.Search(len(idx.entries), func(i int) bool { return idx.entries[i].off > off })
+	if i == 0 {
+		return time.Time{}
+	}
+
+	return idx.entries[i-1].ts
+}
+
+// lineTimestampFunc returns the time the line was written at and the line contents.
+type lineTimestampFunc func(line []byte) (time.Time, []byte)
+
+// timestampReader filters the log lines by the time they were written at
+// and optionally prepends the lines with timestamps.
+type timestampReader struct {
+	io.Closer
+
+	br        *bufio.Reader
+	timestamp lineTimestampFunc
+
+	since, until time.Time
+	timestamps   bool
+
+	buf []byte
+}
+
+// newTimestampReader wraps r, timestamp is called for each line read from r.
+func newTimestampReader(r io.ReadCloser, timestamp lineTimestampFunc, opt *runtime.LogOptions) *timestampReader {
+	return &timestampReader{
+		Closer:     r,
+		br:         bufio.NewReader(r),
+		timestamp:  timestamp,
+		since:      opt.Since,
+		until:      opt.Until,
+		timestamps: opt.Timestamps,
+	}
+}
+
+func (r *timestampReader) matches(ts time.Time) bool {
+	if !r.since.IsZero() && ts.Before(r.since) {
+		return false
+	}
+
+	if !r.until.IsZero() && ts.After(r.until) {
+		return false
+	}
+
+	return true
+}
+
+// Read implements io.Reader.
+func (r *timestampReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		line, err := r.br.ReadBytes('\n')
+
+		if len(line) > 0 {
+			var ts time.Time
+
+			ts, line = r.timestamp(line)
+
+			if r.matches(ts) {
+				if r.timestamps {
+					r.buf = append(r.buf, ts.Format(runtime.LogTimestampLayout)...)
+					r.buf = append(r.buf, ' ')
+				}
+
+				r.buf = append(r.buf, line...)
+			}
+		}
+
+		if err != nil {
+			if len(r.buf) > 0 {
+				break
+			}
+
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package logging
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestLineIndex(t *testing.T) {
+	idx := &lineIndex{}
+
+	var buf bytes.Buffer
+
+	_, err := idx.write(&buf, []byte("first\nsec"))
+	require.NoError(t, err)
+
+	_, err = idx.write(&buf, []byte("ond\nthird\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "first\nsecond\nthird\n", buf.String())
+
+	// partial write doesn't start a new line
+	require.Len(t, idx.entries, 3)
+	assert.EqualValues(t, []int64{0, 6, 13}, []int64{idx.entries[0].off, idx.entries[1].off, idx.entries[2].off})
+
+	assert.True(t, idx.lookup(-1).IsZero())
+	assert.Equal(t, idx.entries[0].ts, idx.lookup(0))
+	assert.Equal(t, idx.entries[0].ts, idx.lookup(5))
+	assert.Equal(t, idx.entries[1].ts, idx.lookup(6))
+	assert.Equal(t, idx.entries[1].ts, idx.lookup(12))
+	assert.Equal(t, idx.entries[2].ts, idx.lookup(100))
+}
+
+func TestLineIndexTrim(t *testing.T) {
+	idx := &lineIndex{window: 20}
+
+	var buf bytes.Buffer
+
+	for i := 0; i < 10; i++ {
+		_, err := idx.write(&buf, []byte("012345678\n"))
+		require.NoError(t, err)
+	}
+
+	// lines partially in the window are kept
+	require.NotEmpty(t, idx.entries)
+	assert.LessOrEqual(t, idx.entries[0].off, int64(80))
+	assert.Less(t, len(idx.entries), 10)
+	assert.EqualValues(t, 90, idx.entries[len(idx.entries)-1].off)
+}
+
+func readTimestamps(t *testing.T, data string, timestamp lineTimestampFunc, opt *runtime.LogOptions) string {
+	r := newTimestampReader(ioutil.NopCloser(bytes.NewBufferString(data)), timestamp, opt)
+
+	contents, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(contents)
+}
+
+func TestTimestampReader(t *testing.T) {
+	base := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	idx := &lineIndex{
+		entries: []lineIndexEntry{
+			{off: 0, ts: base},
+			{off: 4, ts: base.Add(time.Second)},
+			{off: 8, ts: base.Add(2 * time.Second)},
+		},
+	}
+
+	// no last newline
+	data := "one\ntwo\nthree"
+
+	assert.Equal(t, data, readTimestamps(t, data, idx.timestamps(0), &runtime.LogOptions{}))
+
+	// boundaries are inclusive
+	assert.Equal(t, "two\nthree", readTimestamps(t, data, idx.timestamps(0), &runtime.LogOptions{Since: base.Add(time.Second)}))
+	assert.Equal(t, "one\ntwo\n", readTimestamps(t, data, idx.timestamps(0), &runtime.LogOptions{Until: base.Add(time.Second)}))
+	assert.Equal(t, "two\n", readTimestamps(t, data, idx.timestamps(0), &runtime.LogOptions{
+		Since: base.Add(time.Second),
+		Until: base.Add(time.Second),
+	}))
+	assert.Empty(t, readTimestamps(t, data, idx.timestamps(0), &runtime.LogOptions{Since: base.Add(time.Hour)}))
+
+	// reading from the middle of the log
+	assert.Equal(t, "2021-01-01T00:00:01Z two\n2021-01-01T00:00:02Z three",
+		readTimestamps(t, "two\nthree", idx.timestamps(4), &runtime.LogOptions{Timestamps: true}))
+}
+
+func TestTimestampReaderSmallBuffer(t *testing.T) {
+	idx := &lineIndex{}
+
+	var buf bytes.Buffer
+
+	_, err := idx.write(&buf, []byte("first line\nsecond line\n"))
+	require.NoError(t, err)
+
+	r := newTimestampReader(ioutil.NopCloser(&buf), idx.timestamps(0), &runtime.LogOptions{Timestamps: true})
+
+	var out []byte
+
+	p := make([]byte, 3)
+
+	for {
+		n, err := r.Read(p)
+		out = append(out, p[:n]...)
+
+		if err != nil {
+			break
+		}
+	}
+
+	ts := idx.entries[0].ts.Format(runtime.LogTimestampLayout)
+
+	assert.Equal(t, ts+" first line\n"+ts+" second line\n", string(out))
+}
+
+func TestParseLineTimestamp(t *testing.T) {
+	ts, line := parseLineTimestamp([]byte("2021-01-01T00:00:01.5Z some line\n"))
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 1, 500000000, time.UTC), ts)
+	assert.Equal(t, "some line\n", string(line))
+
+	ts, line = parseLineTimestamp([]byte("no timestamp\n"))
+	assert.True(t, ts.IsZero())
+	assert.Equal(t, "no timestamp\n", string(line))
+
+	ts, line = parseLineTimestamp([]byte("line\n"))
+	assert.True(t, ts.IsZero())
+	assert.Equal(t, "line\n", string(line))
+}
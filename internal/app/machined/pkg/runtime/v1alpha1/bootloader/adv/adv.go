@@ -32,4 +32,6 @@ const (
 	StagedUpgradeImageRef
 	// StagedUpgradeInstallOptions stores JSON-serialized install.Options.
 	StagedUpgradeInstallOptions
+	// UpgradeTrial stores the label of the upgraded installation which is booted on trial.
+	UpgradeTrial
 )
@@ -93,8 +93,15 @@ func (m *Meta) Write() error {
 //
 // nolint: gocyclo
 func (m *Meta) Revert() (err error) {
+	// reverted installation is no longer on trial
+	trial := m.ADV.DeleteTag(adv.UpgradeTrial)
+
 	label, ok := m.LegacyADV.ReadTag(adv.Upgrade)
 	if !ok {
+		if trial {
+			return m.Write()
+		}
+
 		return nil
 	}
 
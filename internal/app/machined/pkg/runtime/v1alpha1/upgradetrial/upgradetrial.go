@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package upgradetrial decides whether the upgraded installation booted on trial is confirmed or reverted.
+package upgradetrial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// ErrAlreadyStarted is returned when the trial is already in progress.
+var ErrAlreadyStarted = errors.New("upgrade trial is already in progress")
+
+// Clock provides the timer for the trial window.
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the real clock.
+type SystemClock struct{}
+
+// After implements Clock.
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Trial of the upgraded installation.
+type Trial struct {
+	Label   string
+	Timeout time.Duration
+
+	// WaitHealthy waits for the services to become healthy until ctx is canceled,
+	// and returns the descriptions of the services which are not healthy.
+	WaitHealthy func(ctx context.Context) []string
+
+	// Confirm makes the upgraded installation permanent.
+	Confirm func() error
+	// Revert switches back to the previous installation.
+	//
+	// Node is rebooted into the previous installation on the REVERTED event.
+	Revert func() error
+	// Publish publishes the trial events.
+	Publish func(event *machineapi.UpgradeTrialEvent)
+
+	Clock  Clock
+	Logger *log.Logger
+}
+
+// running is set while the trial is in progress, there is only one upgraded installation on trial.
+var running int32
+
+// Start runs the trial in the background.
+//
+// Trial outlives the boot sequence, as services might become healthy after the sequence is finished.
+// Returned channel is closed when the trial is finished.
+func Start(ctx context.Context, t *Trial) (<-chan struct{}, error) {
+	if !atomic.CompareAndSwapInt32(&running, 0, 1) {
+		return nil, ErrAlreadyStarted
+	}
+
+	t.Publish(&machineapi.UpgradeTrialEvent{
+		Label:   t.Label,
+		Action:  machineapi.UpgradeTrialEvent_START,
+		Message: fmt.Sprintf("waiting %s for machined, apid and kubelet to become healthy", t.Timeout),
+	})
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer atomic.StoreInt32(&running, 0)
+
+		if err := t.Run(ctx); err != nil {
+			t.Logger.Printf("upgrade trial of %q failed: %s", t.Label, err)
+		}
+	}()
+
+	return done, nil
+}
+
+// Run waits for the node to become healthy within the trial window, and confirms or reverts the upgrade.
+func (t *Trial) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result := make(chan []string, 1)
+
+	go func() {
+		result <- t.WaitHealthy(ctx)
+	}()
+
+	var unhealthy []string
+
+	select {
+	case unhealthy = <-result:
+		if ctx.Err() != nil {
+			// trial was aborted, keep the decision for the next boot
+			return ctx.Err()
+		}
+	case <-t.Clock.After(t.Timeout):
+		cancel()
+
+		unhealthy = <-result
+	}
+
+	if len(unhealthy) == 0 {
+		if err := t.Confirm(); err != nil {
+			return fmt.Errorf("failed to confirm upgrade: %w", err)
+		}
+
+		t.Logger.Printf("upgrade to %q is confirmed", t.Label)
+
+		t.Publish(&machineapi.UpgradeTrialEvent{
+			Label:   t.Label,
+			Action:  machineapi.UpgradeTrialEvent_CONFIRMED,
+			Message: "machined, apid and kubelet are healthy",
+		})
+
+		return nil
+	}
+
+	reason := fmt.Sprintf("node didn't become healthy within %s: %s", t.Timeout, strings.Join(unhealthy, "; "))
+
+	t.Logger.Printf("reverting upgrade to %q: %s", t.Label, reason)
+
+	if err := t.Revert(); err != nil {
+		return fmt.Errorf("failed to revert upgrade: %w", err)
+	}
+
+	// event is published once the upgrade is reverted, as it triggers the reboot
+	t.Publish(&machineapi.UpgradeTrialEvent{
+		Label:   t.Label,
+		Action:  machineapi.UpgradeTrialEvent_REVERTED,
+		Message: reason,
+	})
+
+	return nil
+}
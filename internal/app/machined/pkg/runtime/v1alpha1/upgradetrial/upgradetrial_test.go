@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgradetrial_test
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/upgradetrial"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// fakeClock fires the timer when the test asks for it.
+type fakeClock struct {
+	timer chan time.Time
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	return c.timer
+}
+
+type recorder struct {
+	mu sync.Mutex
+
+	actions []string
+	events  []machineapi.UpgradeTrialEvent_Action
+}
+
+func (r *recorder) record(action string) func() error {
+	return func() error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.actions = append(r.actions, action)
+
+		return nil
+	}
+}
+
+func (r *recorder) publish(event *machineapi.UpgradeTrialEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event.Action)
+}
+
+func newTrial(rec *recorder, clock upgradetrial.Clock, waitHealthy func(ctx context.Context) []string) *upgradetrial.Trial {
+	return &upgradetrial.Trial{
+		Label:       "Talos-v0.9.0",
+		Timeout:     10 * time.Minute,
+		WaitHealthy: waitHealthy,
+		Confirm:     rec.record("confirm"),
+		Revert:      rec.record("revert"),
+		Publish:     rec.publish,
+		Clock:       clock,
+		Logger:      log.New(ioutil.Discard, "", 0),
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	var rec recorder
+
+	clock := &fakeClock{timer: make(chan time.Time)}
+
+	done, err := upgradetrial.Start(context.Background(), newTrial(&rec, clock, func(ctx context.Context) []string {
+		return nil
+	}))
+	require.NoError(t, err)
+
+	<-done
+
+	assert.Equal(t, []string{"confirm"}, rec.actions)
+	assert.Equal(t, []machineapi.UpgradeTrialEvent_Action{machineapi.UpgradeTrialEvent_START, machineapi.UpgradeTrialEvent_CONFIRMED}, rec.events)
+}
+
+func TestTimeoutRevert(t *testing.T) {
+	var rec recorder
+
+	clock := &fakeClock{timer: make(chan time.Time)}
+
+	trial := newTrial(&rec, clock, func(ctx context.Context) []string {
+		<-ctx.Done()
+
+		return []string{`service "kubelet" is Waiting`}
+	})
+
+	// node is rebooted on the event, so the upgrade should be already reverted
+	trial.Publish = func(event *machineapi.UpgradeTrialEvent) {
+		if event.Action == machineapi.UpgradeTrialEvent_REVERTED {
+			rec.mu.Lock()
+			assert.Equal(t, []string{"revert"}, rec.actions)
+			rec.mu.Unlock()
+		}
+
+		rec.publish(event)
+	}
+
+	done, err := upgradetrial.Start(context.Background(), trial)
+	require.NoError(t, err)
+
+	clock.timer <- time.Now()
+
+	<-done
+
+	assert.Equal(t, []string{"revert"}, rec.actions)
+	assert.Equal(t, []machineapi.UpgradeTrialEvent_Action{machineapi.UpgradeTrialEvent_START, machineapi.UpgradeTrialEvent_REVERTED}, rec.events)
+}
+
+func TestAbort(t *testing.T) {
+	var rec recorder
+
+	clock := &fakeClock{timer: make(chan time.Time)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done, err := upgradetrial.Start(ctx, newTrial(&rec, clock, func(ctx context.Context) []string {
+		<-ctx.Done()
+
+		return []string{`service "kubelet" is Waiting`}
+	}))
+	require.NoError(t, err)
+
+	cancel()
+
+	<-done
+
+	// aborted trial is neither confirmed nor reverted
+	assert.Empty(t, rec.actions)
+}
+
+func TestDoubleStart(t *testing.T) {
+	var rec recorder
+
+	clock := &fakeClock{timer: make(chan time.Time)}
+	healthy := make(chan struct{})
+
+	trial := newTrial(&rec, clock, func(ctx context.Context) []string {
+		<-healthy
+
+		return nil
+	})
+
+	done, err := upgradetrial.Start(context.Background(), trial)
+	require.NoError(t, err)
+
+	_, err = upgradetrial.Start(context.Background(), trial)
+	assert.Equal(t, upgradetrial.ErrAlreadyStarted, err)
+
+	close(healthy)
+
+	<-done
+
+	assert.Equal(t, []string{"confirm"}, rec.actions)
+
+	// once the trial is finished, it can be started again
+	done, err = upgradetrial.Start(context.Background(), trial)
+	require.NoError(t, err)
+
+	<-done
+
+	assert.Equal(t, []string{"confirm", "confirm"}, rec.actions)
+}
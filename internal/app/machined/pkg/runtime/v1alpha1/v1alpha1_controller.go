@@ -66,7 +66,7 @@ func NewController(b []byte) (*Controller, error) {
 	// TODO: this should be streaming capacity and probably some constant
 	e := NewEvents(1000, 10)
 
-	l := logging.NewCircularBufferLoggingManager()
+	l := logging.NewPersistentLoggingManager()
 
 	ctlr := &Controller{
 		r: NewRuntime(cfg, s, e, l),
@@ -187,6 +187,12 @@ func (c *Controller) ListenForEvents() error {
 		return nil
 	}
 
+	go func() {
+		if err := c.rebootOnUpgradeRevert(); err != nil {
+			log.Printf("reboot after the upgrade revert failed: %v", err)
+		}
+	}()
+
 	go func() {
 		if err := acpi.StartACPIListener(); err != nil {
 			errCh <- err
@@ -210,6 +216,31 @@ func (c *Controller) ListenForEvents() error {
 	return err
 }
 
+// rebootOnUpgradeRevert reboots the node into the previous installation once the upgrade on trial is reverted.
+//
+// Boot sequence might be still running when the upgrade is reverted, so the reboot is forced.
+func (c *Controller) rebootOnUpgradeRevert() error {
+	reverted := make(chan struct{})
+
+	if err := c.r.Events().Watch(func(events <-chan runtime.Event) {
+		for event := range events {
+			if msg, ok := event.Payload.(*machine.UpgradeTrialEvent); ok && msg.Action == machine.UpgradeTrialEvent_REVERTED {
+				close(reverted)
+
+				return
+			}
+		}
+	}); err != nil {
+		return err
+	}
+
+	<-reverted
+
+	log.Printf("reboot via upgrade revert")
+
+	return c.Run(runtime.SequenceReboot, nil, runtime.WithForce())
+}
+
 // TryLock attempts to set a lock that prevents multiple sequences from running
 // at once. If currently locked, a value of true will be returned. If not
 // currently locked, a value of false will be returned.
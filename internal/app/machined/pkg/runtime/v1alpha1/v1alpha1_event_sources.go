@@ -0,0 +1,222 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/pkg/grpc/dialer"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	networkapi "github.com/talos-systems/talos/pkg/machinery/api/network"
+	timeapi "github.com/talos-systems/talos/pkg/machinery/api/time"
+	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const (
+	eventRelayRetryInterval = 5 * time.Second
+	etcdMemberCheckInterval = 30 * time.Second
+)
+
+// StartEventSources starts publishing events for the changes observed outside of machined:
+// addresses configured by networkd, time synchronization status maintained by timed and
+// etcd membership.
+func (c *Controller) StartEventSources(ctx context.Context) {
+	go relayEvents(ctx, c.r.Events(), constants.NetworkSocketPath, func(ctx context.Context, conn *grpc.ClientConn) (func() (proto.Message, error), error) {
+		stream, err := networkapi.NewNetworkServiceClient(conn).Events(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	})
+
+	go relayEvents(ctx, newTimeSyncPublisher(c.r.Events()), constants.TimeSocketPath, func(ctx context.Context, conn *grpc.ClientConn) (func() (proto.Message, error), error) {
+		stream, err := timeapi.NewTimeServiceClient(conn).Events(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	})
+
+	go watchEtcdMembers(ctx, c.r)
+}
+
+// relayEvents subscribes to the events of the service listening on the socket and publishes them.
+//
+// Services are restarted independently of machined, so the subscription is re-established
+// until the context is canceled.
+func relayEvents(ctx context.Context, publisher runtime.Publisher, socketPath string,
+	subscribe func(context.Context, *grpc.ClientConn) (func() (proto.Message, error), error)) {
+	for {
+		// service might be not running yet or restarting, so the errors are retried silently
+		relayEventsOnce(ctx, publisher, socketPath, subscribe) //nolint: errcheck
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventRelayRetryInterval):
+		}
+	}
+}
+
+func relayEventsOnce(ctx context.Context, publisher runtime.Publisher, socketPath string,
+	subscribe func(context.Context, *grpc.ClientConn) (func() (proto.Message, error), error)) error {
+	conn, err := grpc.DialContext(
+		ctx,
+		fmt.Sprintf("%s://%s", "unix", socketPath),
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialer.DialUnix()),
+	)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer conn.Close()
+
+	recv, err := subscribe(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, recvErr := recv()
+		if recvErr != nil {
+			return recvErr
+		}
+
+		publisher.Publish(msg)
+	}
+}
+
+// timeSyncPublisher publishes time synchronization status only when it changes.
+//
+// timed sends current status on each subscription, which shouldn't be published again
+// when the subscription is re-established.
+type timeSyncPublisher struct {
+	publisher runtime.Publisher
+
+	synced bool
+	known  bool
+}
+
+func newTimeSyncPublisher(publisher runtime.Publisher) *timeSyncPublisher {
+	return &timeSyncPublisher{
+		publisher: publisher,
+	}
+}
+
+// Publish implements runtime.Publisher interface.
+func (p *timeSyncPublisher) Publish(msg proto.Message) {
+	event, ok := msg.(*machine.TimeSyncEvent)
+	if !ok {
+		return
+	}
+
+	if p.known && p.synced == event.Synced {
+		return
+	}
+
+	p.synced, p.known = event.Synced, true
+
+	p.publisher.Publish(event)
+}
+
+// watchEtcdMembers publishes etcd membership changes observed on control plane nodes.
+func watchEtcdMembers(ctx context.Context, r runtime.Runtime) {
+	ticker := time.NewTicker(etcdMemberCheckInterval)
+	defer ticker.Stop()
+
+	// members is nil until the first successful listing, which is used as a baseline
+	var members map[uint64]*etcdserverpb.Member
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if r.Config() == nil || r.Config().Machine().Type() == machinetype.TypeJoin {
+			continue
+		}
+
+		if _, running, err := system.Services(r).IsRunning("etcd"); err != nil || !running {
+			continue
+		}
+
+		current, err := listEtcdMembers(ctx)
+		if err != nil {
+			continue
+		}
+
+		if members != nil {
+			for id, member := range current {
+				if _, ok := members[id]; !ok {
+					r.Events().Publish(etcdMemberEvent(member, machine.EtcdMemberEvent_ADDED))
+				}
+			}
+
+			for id, member := range members {
+				if _, ok := current[id]; !ok {
+					r.Events().Publish(etcdMemberEvent(member, machine.EtcdMemberEvent_REMOVED))
+				}
+			}
+		}
+
+		members = current
+	}
+}
+
+func listEtcdMembers(ctx context.Context) (map[uint64]*etcdserverpb.Member, error) {
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, etcd.QuorumCheckTimeout)
+	defer cancel()
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[uint64]*etcdserverpb.Member, len(resp.Members))
+
+	for _, member := range resp.Members {
+		members[member.ID] = member
+	}
+
+	return members, nil
+}
+
+func etcdMemberEvent(member *etcdserverpb.Member, action machine.EtcdMemberEvent_Action) *machine.EtcdMemberEvent {
+	return &machine.EtcdMemberEvent{
+		MemberId: member.ID,
+		Name:     member.Name,
+		PeerUrls: member.PeerURLs,
+		Action:   action,
+	}
+}
@@ -168,11 +168,30 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 	go func() {
 		defer close(ch)
 
+		if !opts.Until.IsZero() {
+			// wake up the consumer once until is in the past, so that it stops waiting for new events
+			timer := time.AfterFunc(time.Until(opts.Until), func() {
+				e.mu.Lock()
+				defer e.mu.Unlock()
+
+				e.c.Broadcast()
+			})
+
+			defer timer.Stop()
+		}
+
 		for {
 			e.mu.Lock()
 			// while there's no data to consume (pos == e.writePos), wait for Condition variable signal,
 			// then recheck the condition to be true.
 			for pos == e.writePos {
+				if !opts.Until.IsZero() && time.Now().After(opts.Until) {
+					// all events published before until are consumed
+					e.mu.Unlock()
+
+					return
+				}
+
 				e.c.Wait()
 
 				select {
@@ -193,6 +212,13 @@ func (e *Events) Watch(f runtime.WatchFunc, opt ...runtime.WatchOptionFunc) erro
 			}
 
 			event := e.stream[pos%int64(e.cap)]
+
+			if !opts.Until.IsZero() && event.ID.Time().After(opts.Until) {
+				e.mu.Unlock()
+
+				return
+			}
+
 			pos++
 
 			e.mu.Unlock()
@@ -254,6 +254,71 @@ func TestEvents_WatchOptionsTailID(t *testing.T) {
 	}
 }
 
+// receiveAll receives events until the watch channel is closed.
+func receiveAll(t *testing.T, e runtime.Watcher, opts ...runtime.WatchOptionFunc) (result []runtime.Event) {
+	done := make(chan struct{})
+
+	if err := e.Watch(func(events <-chan runtime.Event) {
+		defer close(done)
+
+		for event := range events {
+			result = append(result, event)
+		}
+	}, opts...); err != nil {
+		t.Fatalf("Watch() error %s", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Watch: channel is not closed")
+	}
+
+	return result
+}
+
+func TestEvents_WatchOptionsUntil(t *testing.T) {
+	e := NewEvents(100, 10)
+
+	for i := 0; i < 20; i++ {
+		e.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	until := time.Now()
+
+	// sleep to get time gap between two series of events
+	time.Sleep(2 * time.Second)
+
+	for i := 20; i < 30; i++ {
+		e.Publish(&machine.SequenceEvent{
+			Sequence: strconv.Itoa(i),
+		})
+	}
+
+	// buffered events after until are skipped
+	assert.Equal(t, gen(0, 20), extractSeq(t, receiveAll(t, e, runtime.WithTailEvents(-1), runtime.WithUntil(until))))
+	assert.Equal(t, gen(0, 20), extractSeq(t, receiveAll(t, e, runtime.WithTailDuration(time.Minute), runtime.WithUntil(until))))
+	assert.Equal(t, []int(nil), extractSeq(t, receiveAll(t, e, runtime.WithUntil(until))))
+
+	// buffered events published before until are delivered even if until is in the past
+	assert.Equal(t, gen(0, 30), extractSeq(t, receiveAll(t, e, runtime.WithTailEvents(-1), runtime.WithUntil(time.Now()))))
+
+	// until in the future: new events are delivered until the end of the range
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		for i := 30; i < 35; i++ {
+			e.Publish(&machine.SequenceEvent{
+				Sequence: strconv.Itoa(i),
+			})
+		}
+	}()
+
+	assert.Equal(t, gen(30, 35), extractSeq(t, receiveAll(t, e, runtime.WithUntil(time.Now().Add(time.Second)))))
+}
+
 func BenchmarkWatch(b *testing.B) {
 	e := NewEvents(100, 10)
 
@@ -218,6 +218,14 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"var",
 		SetupVarDirectory,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer && r.Config().Machine().Events().Persist(),
+		"persistEvents",
+		PersistEvents,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"persistLogs",
+		PersistLogs,
 	).AppendWhen(
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"overlay",
@@ -238,9 +246,17 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"lvm",
 		ActivateLogicalVolumes,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"upgradeTrial",
+		StartUpgradeTrial,
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"imageCache",
+		ImportImageCache,
 	).Append(
 		"startEverything",
-		StartAllServices,
+		startEverythingTasks(r)...,
 	).AppendWhen(
 		r.Config().Machine().Type() != machine.TypeJoin,
 		"labelMaster",
@@ -393,8 +409,10 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 			"unmount",
 			UnmountOverlayFilesystems,
 			UnmountPodMounts,
+			UnpersistLogs,
 		).Append(
 			"unmountSystem",
+			UnmountImageCachePartition,
 			UnmountEphemeralPartition,
 			UnmountStatePartition,
 		).Append(
@@ -418,6 +436,17 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 	return phases
 }
 
+func startEverythingTasks(r runtime.Runtime) []runtime.TaskSetupFunc {
+	tasks := []runtime.TaskSetupFunc{StartAllServices}
+
+	if r.State().Platform().Mode() != runtime.ModeContainer && r.Config().Machine().Install().ImageCache().Enabled() {
+		// runs alongside the services, as CRI containerd is started with them
+		tasks = append(tasks, ImportKubernetesImageCache)
+	}
+
+	return tasks
+}
+
 func stopAllPhaselist(r runtime.Runtime) PhaseList {
 	phases := PhaseList{}
 
@@ -435,8 +464,10 @@ func stopAllPhaselist(r runtime.Runtime) PhaseList {
 			"umount",
 			UnmountOverlayFilesystems,
 			UnmountPodMounts,
+			UnpersistLogs,
 		).Append(
 			"unmountSystem",
+			UnmountImageCachePartition,
 			UnmountEphemeralPartition,
 			UnmountStatePartition,
 		).Append(
@@ -25,9 +25,12 @@ import (
 	"text/template"
 	"time"
 
+	containerdapi "github.com/containerd/containerd"
+	criconstants "github.com/containerd/cri/pkg/constants"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/partition/gpt"
+	"github.com/talos-systems/go-blockdevice/blockdevice/probe"
 	"github.com/talos-systems/go-blockdevice/blockdevice/util"
 	"github.com/talos-systems/go-procfs/procfs"
 	"github.com/talos-systems/go-retry/retry"
@@ -37,10 +40,12 @@ import (
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/internal/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	perrors "github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/upgradetrial"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
@@ -48,8 +53,10 @@ import (
 	"github.com/talos-systems/talos/internal/app/networkd/pkg/networkd"
 	"github.com/talos-systems/talos/internal/app/timed/pkg/ntp"
 	"github.com/talos-systems/talos/internal/pkg/containers/cri/containerd"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/cri"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/eventlog"
 	"github.com/talos-systems/talos/internal/pkg/kernel/kspp"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/internal/pkg/mount"
@@ -718,6 +725,18 @@ func StartAllServices(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 			return fmt.Errorf("unexpected machine type: %s", r.Config().Machine().Type())
 		}
 
+		if r.Config().Machine().Metrics().BindAddress() != "" {
+			svcs.Load(
+				&services.Metrics{},
+			)
+		}
+
+		for _, check := range r.Config().Machine().HealthChecks() {
+			svcs.Load(
+				&services.HealthCheck{Check: check},
+			)
+		}
+
 		system.Services(r).StartAll()
 
 		all := []conditions.Condition{}
@@ -822,6 +841,80 @@ func SetupVarDirectory(seq runtime.Sequence, data interface{}) (runtime.TaskExec
 	}, "setupVarDirectory"
 }
 
+// PersistEvents represents the PersistEvents task.
+//
+// Events are written to the event log starting with the oldest event still available
+// in memory, so that early boot events are persisted as well.
+func PersistEvents(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		bootID, err := eventlog.CurrentBootID()
+		if err != nil {
+			return fmt.Errorf("error reading boot ID: %w", err)
+		}
+
+		dir, err := eventlog.Dir(r.Config().Machine().Events().Partition())
+		if err != nil {
+			return err
+		}
+
+		eventLog := eventlog.New(dir, r.Config().Machine().Events().MaxSize())
+
+		return r.Events().Watch(func(events <-chan runtime.Event) {
+			var lastErr error
+
+			for event := range events {
+				msg, marshalErr := event.ToMachineEvent()
+				if marshalErr != nil {
+					continue
+				}
+
+				msg.BootId = bootID
+
+				appendErr := eventLog.Append(msg)
+				if appendErr != nil && lastErr == nil {
+					// log only the first error in the series to avoid flooding the log,
+					// e.g. when the partition gets unmounted on shutdown
+					logger.Printf("error persisting event: %s", appendErr)
+				}
+
+				lastErr = appendErr
+			}
+		}, runtime.WithTailEvents(-1))
+	}, "persistEvents"
+}
+
+// PersistLogs represents the PersistLogs task.
+//
+// Service logs kept in memory since the boot are written to the log files,
+// the log files are rotated according to the machine config.
+func PersistLogs(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		manager, ok := r.Logging().(*logging.PersistentLoggingManager)
+		if !ok {
+			return fmt.Errorf("logging manager doesn't support log persistence")
+		}
+
+		return manager.Persist(logging.NewFileLoggingManager(
+			constants.LogPath,
+			logging.WithLoggingConfig(r.Config().Machine().Logging()),
+			logging.WithLineTimestamps(),
+		))
+	}, "persistLogs"
+}
+
+// UnpersistLogs represents the UnpersistLogs task.
+//
+// Log files are closed so that the ephemeral partition can be unmounted.
+func UnpersistLogs(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		if manager, ok := r.Logging().(*logging.PersistentLoggingManager); ok {
+			manager.Unpersist()
+		}
+
+		return nil
+	}, "unpersistLogs"
+}
+
 // MountUserDisks represents the MountUserDisks task.
 func MountUserDisks(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1478,6 +1571,12 @@ func UpdateBootloader(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 		// nolint: errcheck
 		defer meta.Close()
 
+		if label, ok := meta.ADV.ReadTag(adv.UpgradeTrial); ok {
+			logger.Printf("upgrade to %q is on trial, keeping fallback", label)
+
+			return nil
+		}
+
 		if ok := meta.LegacyADV.DeleteTag(adv.Upgrade); ok {
 			logger.Println("removing fallback")
 
@@ -1490,6 +1589,122 @@ func UpdateBootloader(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 	}, "updateBootloader"
 }
 
+// StartUpgradeTrial represents the StartUpgradeTrial task.
+//
+// Upgraded installation is booted on trial: machined, apid and kubelet should become
+// healthy within the configured window, otherwise the upgrade is reverted and the node reboots.
+func StartUpgradeTrial(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		meta, err := bootloader.NewMeta()
+		if err != nil {
+			return err
+		}
+		// nolint: errcheck
+		defer meta.Close()
+
+		label, ok := meta.ADV.ReadTag(adv.UpgradeTrial)
+		if !ok {
+			return nil
+		}
+
+		timeout := r.Config().Machine().Install().UpgradeHealthTimeout()
+
+		logger.Printf("upgrade to %q is on trial, waiting %s for the node to become healthy", label, timeout)
+
+		_, err = upgradetrial.Start(context.Background(), &upgradetrial.Trial{
+			Label:   label,
+			Timeout: timeout,
+			WaitHealthy: func(ctx context.Context) []string {
+				return waitUpgradeTrialServices(ctx, r)
+			},
+			Confirm: confirmUpgradeTrial,
+			Revert:  revertUpgradeTrial,
+			// controller reboots the node on the REVERTED event
+			Publish: func(event *machineapi.UpgradeTrialEvent) {
+				r.Events().Publish(event)
+			},
+			Clock:  upgradetrial.SystemClock{},
+			Logger: log.New(log.Writer(), log.Prefix(), log.Flags()),
+		})
+
+		return err
+	}, "startUpgradeTrial"
+}
+
+// waitUpgradeTrialServices waits for machined, apid and kubelet to become healthy.
+func waitUpgradeTrialServices(ctx context.Context, r runtime.Runtime) []string {
+	var unhealthy []string
+
+	for _, svc := range []system.Service{&services.Machined{}, &services.APID{}, &services.Kubelet{}} {
+		id := svc.ID(r)
+
+		if err := waitForServiceUp(ctx, id); err != nil {
+			unhealthy = append(unhealthy, describeService(r, id))
+		}
+	}
+
+	return unhealthy
+}
+
+// waitForServiceUp waits for the service to be registered and to become healthy.
+func waitForServiceUp(ctx context.Context, id string) error {
+	for {
+		err := system.WaitForService(system.StateEventUp, id).Wait(ctx)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		// service is not registered yet
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func describeService(r runtime.Runtime, id string) string {
+	for _, svcrunner := range system.Services(r).List() {
+		svc := svcrunner.AsProto()
+		if svc.GetId() != id {
+			continue
+		}
+
+		if health := svc.GetHealth(); health != nil && !health.GetUnknown() && !health.GetHealthy() {
+			return fmt.Sprintf("service %q is %s and unhealthy: %s", id, svc.GetState(), health.GetLastMessage())
+		}
+
+		return fmt.Sprintf("service %q is %s", id, svc.GetState())
+	}
+
+	return fmt.Sprintf("service %q is not started", id)
+}
+
+func confirmUpgradeTrial() error {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer meta.Close()
+
+	meta.ADV.DeleteTag(adv.UpgradeTrial)
+	meta.LegacyADV.DeleteTag(adv.Upgrade)
+
+	return meta.Write()
+}
+
+func revertUpgradeTrial() error {
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer meta.Close()
+
+	return meta.Revert()
+}
+
 // Reboot represents the Reboot task.
 func Reboot(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1602,6 +1817,84 @@ func UnmountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.
 	}, "unmountEphemeralPartition"
 }
 
+// ImportImageCache mounts the image cache partition (if it exists) and imports
+// the cached images into the system containerd.
+//
+// Images for the k8s.io namespace are imported by ImportKubernetesImageCache, as CRI containerd
+// is not running at this point.
+func ImportImageCache(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		var dev *probe.ProbedBlockDevice
+
+		if dev, err = probe.GetDevWithFileSystemLabel(constants.ImageCachePartitionLabel); err != nil {
+			logger.Printf("image cache partition not found, skipping import")
+
+			return nil
+		}
+
+		dev.Close() //nolint: errcheck
+
+		if err = mount.SystemPartitionMount(constants.ImageCachePartitionLabel, mount.WithSkipIfMounted(true)); err != nil {
+			return err
+		}
+
+		client, err := containerdapi.New(constants.SystemContainerdAddress)
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer client.Close()
+
+		return image.ImportCache(ctx, client, constants.SystemContainerdNamespace)
+	}, "importImageCache"
+}
+
+// ImportKubernetesImageCache waits for CRI containerd to start and imports the cached images
+// into the k8s.io namespace.
+//
+// Kubelet waits for constants.ImageCacheImportedMarker, so the import is done once per boot
+// before the kubelet starts.
+func ImportKubernetesImageCache(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		ctx, cancel := context.WithTimeout(ctx, constants.BootTimeout)
+		defer cancel()
+
+		if err = waitForServiceUp(ctx, "cri"); err != nil {
+			return err
+		}
+
+		client, err := containerdapi.New(constants.ContainerdAddress)
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer client.Close()
+
+		// cache partition is not mounted if it doesn't exist, so there is nothing to import
+		if err = image.ImportCache(ctx, client, criconstants.K8sContainerdNamespace); err != nil {
+			return fmt.Errorf("failed to import image cache: %w", err)
+		}
+
+		return ioutil.WriteFile(constants.ImageCacheImportedMarker, nil, 0o600)
+	}, "importKubernetesImageCache"
+}
+
+// UnmountImageCachePartition unmounts the image cache partition if it's mounted.
+func UnmountImageCachePartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		mountpoint := mount.NewMountPoint("", constants.ImageCacheMountPoint, "", 0, "")
+
+		mounted, err := mountpoint.IsMounted()
+		if err != nil || !mounted {
+			return err
+		}
+
+		return mountpoint.Unmount()
+	}, "unmountImageCachePartition"
+}
+
 // Install mounts or installs the system partitions.
 func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1620,6 +1913,8 @@ func Install(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc,
 				install.WithForce(true),
 				install.WithZero(r.Config().Machine().Install().Zero()),
 				install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+				install.WithImageCache(install.ImageCacheList(r.Config())),
+				install.WithImageCacheSource(r.Config().Machine().Install().ImageCache().Path()),
 			)
 			if err != nil {
 				return err
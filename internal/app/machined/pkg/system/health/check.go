@@ -34,6 +34,7 @@ func Run(ctx context.Context, settings *Settings, state *State, check Check) err
 		err            error
 		healthy        bool
 		message        string
+		failures       int
 		checkCtx       context.Context
 		checkCtxCancel context.CancelFunc
 	)
@@ -49,11 +50,16 @@ func Run(ctx context.Context, settings *Settings, state *State, check Check) err
 		healthy = err == nil
 		message = ""
 
-		if !healthy {
+		if healthy {
+			failures = 0
+		} else {
 			message = err.Error()
+			failures++
 		}
 
-		state.Update(healthy, message)
+		if healthy || failures >= settings.FailureThreshold {
+			state.Update(healthy, message)
+		}
 
 		select {
 		case <-ctx.Done():
@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package health
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/rs/xid"
+
+	ctrd "github.com/talos-systems/talos/internal/pkg/containers/containerd"
+)
+
+// HTTPCheck returns a health check which performs HTTP GET request to the url.
+//
+// Check is successful if the response status code is 2xx or 3xx.
+func HTTPCheck(url string) Check {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer resp.Body.Close()
+
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+// TCPCheck returns a health check which opens TCP connection to the address.
+//
+// Check is successful if the connection is established.
+func TCPCheck(address string) Check {
+	return func(ctx context.Context) error {
+		var d net.Dialer
+
+		conn, err := d.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+}
+
+// ExecCheck returns a health check which runs the command in the running container.
+//
+// Container ID might be either containerd ID or Kubernetes-style `namespace/pod:container`.
+// Check is successful if the command exits with zero exit code.
+//
+//nolint: gocyclo
+func ExecCheck(address, namespace, containerID string, command []string) Check {
+	return func(ctx context.Context) error {
+		inspector, err := ctrd.NewInspector(ctx, namespace, ctrd.WithContainerdAddress(address))
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer inspector.Close()
+
+		cntr, err := inspector.Container(containerID)
+		if err != nil {
+			return err
+		}
+
+		if cntr == nil {
+			return fmt.Errorf("container %q not found", containerID)
+		}
+
+		client, err := containerd.New(address)
+		if err != nil {
+			return err
+		}
+
+		//nolint: errcheck
+		defer client.Close()
+
+		ctx = namespaces.WithNamespace(ctx, namespace)
+
+		container, err := client.LoadContainer(ctx, cntr.ID)
+		if err != nil {
+			return err
+		}
+
+		spec, err := container.Spec(ctx)
+		if err != nil {
+			return err
+		}
+
+		task, err := container.Task(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		pspec := *spec.Process
+		pspec.Args = command
+		pspec.Terminal = false
+
+		var output bytes.Buffer
+
+		process, err := task.Exec(ctx, xid.New().String(), &pspec, cio.NewCreator(cio.WithStreams(nil, &output, &output)))
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			// process should be cleaned up even if the check timed out
+			deleteCtx, deleteCancel := context.WithTimeout(namespaces.WithNamespace(context.Background(), namespace), 5*time.Second)
+			defer deleteCancel()
+
+			process.Delete(deleteCtx, containerd.WithProcessKill) //nolint: errcheck
+		}()
+
+		statusC, err := process.Wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = process.Start(ctx); err != nil {
+			return err
+		}
+
+		var status containerd.ExitStatus
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case status = <-statusC:
+		}
+
+		code, _, err := status.Result()
+		if err != nil {
+			return err
+		}
+
+		process.IO().Wait()
+
+		if code != 0 {
+			return fmt.Errorf("command exited with code %d: %s", code, strings.TrimSpace(output.String()))
+		}
+
+		return nil
+	}
+}
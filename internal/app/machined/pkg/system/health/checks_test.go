@@ -0,0 +1,255 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package health_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	containerdrunner "github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/process"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const checkTimeout = 100 * time.Millisecond
+
+func runCheck(check health.Check) error {
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	return check(ctx)
+}
+
+func TestHTTPCheck(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusNoContent)
+		case "/moved":
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+		case "/hang":
+			<-r.Context().Done()
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	assert.NoError(t, runCheck(health.HTTPCheck(srv.URL+"/healthz")))
+	assert.NoError(t, runCheck(health.HTTPCheck(srv.URL+"/moved")))
+
+	assert.EqualError(t, runCheck(health.HTTPCheck(srv.URL+"/fail")), "unexpected HTTP status 503")
+
+	err := runCheck(health.HTTPCheck(srv.URL + "/hang"))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "unexpected error %v", err)
+}
+
+func TestTCPCheck(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := l.Addr().String()
+
+	assert.NoError(t, runCheck(health.TCPCheck(address)))
+
+	require.NoError(t, l.Close())
+
+	// nothing listens on the port anymore
+	assert.Error(t, runCheck(health.TCPCheck(address)))
+
+	// connection can't be established before the deadline
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	assert.Error(t, health.TCPCheck(address)(ctx))
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
+
+const busyboxImage = "docker.io/library/busybox:1.30.1"
+
+func MockEventSink(state events.ServiceState, message string, args ...interface{}) {
+}
+
+type ExecCheckSuite struct {
+	suite.Suite
+
+	tmpDir string
+
+	loggingManager runtime.LoggingManager
+
+	containerdNamespace string
+	containerdRunner    runner.Runner
+	containerdWg        sync.WaitGroup
+	containerdAddress   string
+
+	containerID     string
+	containerRunner runner.Runner
+	containerWg     sync.WaitGroup
+
+	client *containerd.Client
+}
+
+func (suite *ExecCheckSuite) SetupSuite() {
+	var err error
+
+	suite.tmpDir, err = ioutil.TempDir("", "talos")
+	suite.Require().NoError(err)
+
+	suite.loggingManager = logging.NewFileLoggingManager(suite.tmpDir)
+
+	stateDir, rootDir := filepath.Join(suite.tmpDir, "state"), filepath.Join(suite.tmpDir, "root")
+	suite.Require().NoError(os.Mkdir(stateDir, 0o777))
+	suite.Require().NoError(os.Mkdir(rootDir, 0o777))
+
+	suite.containerdAddress = filepath.Join(suite.tmpDir, "run.sock")
+
+	args := &runner.Args{
+		ID: "containerd",
+		ProcessArgs: []string{
+			"/bin/containerd",
+			"--address", suite.containerdAddress,
+			"--state", stateDir,
+			"--root", rootDir,
+			"--config", constants.CRIContainerdConfig,
+		},
+	}
+
+	suite.containerdRunner = process.NewRunner(
+		false,
+		args,
+		runner.WithLoggingManager(suite.loggingManager),
+		runner.WithEnv([]string{"PATH=/bin:" + constants.PATH}),
+	)
+	suite.Require().NoError(suite.containerdRunner.Open(context.Background()))
+	suite.containerdWg.Add(1)
+
+	go func() {
+		defer suite.containerdWg.Done()
+		defer suite.containerdRunner.Close()      //nolint: errcheck
+		suite.containerdRunner.Run(MockEventSink) //nolint: errcheck
+	}()
+
+	suite.client, err = containerd.New(suite.containerdAddress)
+	suite.Require().NoError(err)
+
+	namespace := ([16]byte)(uuid.New())
+	suite.containerdNamespace = "talos" + hex.EncodeToString(namespace[:])
+
+	ctx := namespaces.WithNamespace(context.Background(), suite.containerdNamespace)
+
+	_, err = suite.client.Pull(ctx, busyboxImage, containerd.WithPullUnpack)
+	suite.Require().NoError(err)
+
+	suite.containerID = uuid.New().String()
+
+	suite.containerRunner = containerdrunner.NewRunner(false, &runner.Args{
+		ID:          suite.containerID,
+		ProcessArgs: []string{"/bin/sh", "-c", "sleep 3600"},
+	},
+		runner.WithLoggingManager(suite.loggingManager),
+		runner.WithNamespace(suite.containerdNamespace),
+		runner.WithContainerImage(busyboxImage),
+		runner.WithContainerdAddress(suite.containerdAddress),
+	)
+	suite.Require().NoError(suite.containerRunner.Open(context.Background()))
+
+	runningCh := make(chan bool, 2)
+
+	suite.containerWg.Add(1)
+
+	go func() {
+		runningSink := func(state events.ServiceState, message string, args ...interface{}) {
+			if state == events.StateRunning {
+				runningCh <- true
+			}
+		}
+
+		defer func() { runningCh <- false }()
+		defer suite.containerWg.Done()
+		suite.containerRunner.Run(runningSink) //nolint: errcheck
+	}()
+
+	suite.Require().True(<-runningCh, "container failed to start")
+}
+
+func (suite *ExecCheckSuite) TearDownSuite() {
+	suite.Assert().NoError(suite.containerRunner.Stop())
+	suite.containerWg.Wait()
+	suite.Assert().NoError(suite.containerRunner.Close())
+
+	suite.Require().NoError(suite.client.Close())
+
+	suite.Require().NoError(suite.containerdRunner.Stop())
+	suite.containerdWg.Wait()
+
+	suite.Require().NoError(os.RemoveAll(suite.tmpDir))
+}
+
+func (suite *ExecCheckSuite) check(containerID string, command ...string) health.Check {
+	return health.ExecCheck(suite.containerdAddress, suite.containerdNamespace, containerID, command)
+}
+
+func (suite *ExecCheckSuite) TestSuccess() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	suite.Assert().NoError(suite.check(suite.containerID, "/bin/sh", "-c", "test -d /proc")(ctx))
+}
+
+func (suite *ExecCheckSuite) TestFailure() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	suite.Assert().EqualError(suite.check(suite.containerID, "/bin/sh", "-c", "echo not healthy; exit 3")(ctx), "command exited with code 3: not healthy")
+}
+
+func (suite *ExecCheckSuite) TestTimeout() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	suite.Assert().Equal(context.DeadlineExceeded, suite.check(suite.containerID, "/bin/sleep", "3600")(ctx))
+}
+
+func (suite *ExecCheckSuite) TestContainerNotFound() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	suite.Assert().EqualError(suite.check("nosuchcontainer", "/bin/true")(ctx), `container "nosuchcontainer" not found`)
+}
+
+func TestExecCheckSuite(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("can't run the test as non-root")
+	}
+
+	_, err := os.Stat("/bin/containerd")
+	if err != nil {
+		t.Skip("containerd binary is not available, skipping the test")
+	}
+
+	suite.Run(t, new(ExecCheckSuite))
+}
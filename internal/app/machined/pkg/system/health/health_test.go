@@ -135,6 +135,54 @@ func (suite *CheckSuite) TestHealthChange() {
 	suite.Assert().True(*change.New.Healthy)
 }
 
+func (suite *CheckSuite) TestFailureThreshold() {
+	settings := health.Settings{
+		InitialDelay:     time.Millisecond,
+		Period:           time.Millisecond,
+		Timeout:          time.Millisecond,
+		FailureThreshold: 3,
+	}
+
+	var (
+		state   health.State
+		called  uint32
+		unknown uint32
+	)
+
+	check := func(context.Context) error {
+		// health should stay unknown until the third failure is recorded
+		if atomic.AddUint32(&called, 1) == 3 && state.Get().Healthy == nil {
+			atomic.StoreUint32(&unknown, 1)
+		}
+
+		return errors.New("health failed")
+	}
+
+	errCh := make(chan error)
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	go func() {
+		errCh <- health.Run(ctx, &settings, &state, check)
+	}()
+
+	for i := 0; i < 20; i++ {
+		if state.Get().Healthy != nil {
+			break
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	ctxCancel()
+
+	suite.Assert().EqualError(<-errCh, context.Canceled.Error())
+
+	suite.Require().NotNil(state.Get().Healthy)
+	suite.Assert().False(*state.Get().Healthy)
+	suite.Assert().Equal("health failed", state.Get().LastMessage)
+	suite.Assert().EqualValues(1, atomic.LoadUint32(&unknown))
+}
+
 func (suite *CheckSuite) TestCheckAbort() {
 	settings := health.Settings{
 		InitialDelay: time.Millisecond,
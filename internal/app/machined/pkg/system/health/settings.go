@@ -13,6 +13,9 @@ type Settings struct {
 	InitialDelay time.Duration
 	Period       time.Duration
 	Timeout      time.Duration
+	// FailureThreshold is the number of consecutive failures required to report
+	// the check as unhealthy, zero value is the same as 1.
+	FailureThreshold int
 }
 
 // DefaultSettings provides some default health check settings.
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"fmt"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	healthapi "github.com/talos-systems/talos/pkg/machinery/api/health"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// RequiredServices returns the list of services which should be running and healthy
+// for the node of the specified type to be ready.
+func RequiredServices(machineType machine.Type) []string {
+	required := []string{"apid", "containerd", "cri", "kubelet", "networkd"}
+
+	switch machineType { //nolint: exhaustive
+	case machine.TypeInit, machine.TypeControlPlane:
+		required = append(required, "etcd", "trustd")
+	}
+
+	return required
+}
+
+// HealthCheckServiceID returns the ID of the service which exposes the custom health check.
+func HealthCheckServiceID(name string) string {
+	return "healthcheck-" + name
+}
+
+// NodeReadiness aggregates the state and health of the services into the node readiness.
+//
+// Node is ready when all the required services are running, and the required services which
+// have health checks are healthy. Other services are reported, but they don't affect readiness.
+func NodeReadiness(runners []*ServiceRunner, required []string) *healthapi.NodeReady {
+	result := &healthapi.NodeReady{
+		Ready: true,
+	}
+
+	requiredSet := make(map[string]struct{}, len(required))
+
+	for _, id := range required {
+		requiredSet[id] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(runners))
+
+	for _, svcrunner := range runners {
+		readiness := svcrunner.readiness()
+
+		seen[readiness.Id] = struct{}{}
+
+		if _, ok := requiredSet[readiness.Id]; ok {
+			readiness.Required = true
+
+			if reason := notReadyReason(svcrunner, readiness); reason != "" {
+				result.Ready = false
+				result.Reasons = append(result.Reasons, reason)
+			}
+		}
+
+		result.Services = append(result.Services, readiness)
+	}
+
+	for _, id := range required {
+		if _, ok := seen[id]; !ok {
+			result.Ready = false
+			result.Reasons = append(result.Reasons, fmt.Sprintf("service %q is not registered", id))
+		}
+	}
+
+	return result
+}
+
+func (svcrunner *ServiceRunner) readiness() *healthapi.ServiceReadiness {
+	info := svcrunner.AsProto()
+
+	readiness := &healthapi.ServiceReadiness{
+		Id:            info.Id,
+		State:         info.State,
+		Healthy:       info.GetHealth().GetHealthy(),
+		HealthUnknown: info.GetHealth().GetUnknown(),
+	}
+
+	if info.GetHealth().GetLastMessage() != "" {
+		readiness.Message = info.GetHealth().GetLastMessage()
+	} else if len(info.GetEvents().GetEvents()) > 0 {
+		readiness.Message = info.Events.Events[len(info.Events.Events)-1].Msg
+	}
+
+	return readiness
+}
+
+func notReadyReason(svcrunner *ServiceRunner, readiness *healthapi.ServiceReadiness) string {
+	if readiness.State != events.StateRunning.String() {
+		return fmt.Sprintf("service %q is not running: state %s: %s", readiness.Id, readiness.State, readiness.Message)
+	}
+
+	if _, ok := svcrunner.service.(HealthcheckedService); !ok {
+		return ""
+	}
+
+	switch {
+	case readiness.HealthUnknown:
+		return fmt.Sprintf("service %q health is not known yet", readiness.Id)
+	case !readiness.Healthy:
+		return fmt.Sprintf("service %q is not healthy: %s", readiness.Id, readiness.Message)
+	default:
+		return ""
+	}
+}
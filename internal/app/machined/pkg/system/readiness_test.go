@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+func TestRequiredServices(t *testing.T) {
+	assert.NotContains(t, system.RequiredServices(machine.TypeJoin), "etcd")
+	assert.Contains(t, system.RequiredServices(machine.TypeControlPlane), "etcd")
+	assert.Contains(t, system.RequiredServices(machine.TypeInit), "trustd")
+}
+
+func TestNodeReadiness(t *testing.T) {
+	running := system.NewServiceRunner(&MockService{name: "running"}, nil)
+	running.UpdateState(events.StateRunning, "Running")
+
+	failed := system.NewServiceRunner(&MockService{name: "failed"}, nil)
+	failed.UpdateState(events.StateFailed, "exit status 1")
+
+	healthchecked := system.NewServiceRunner(&MockHealthcheckedService{MockService: MockService{name: "healthchecked"}}, nil)
+	healthchecked.UpdateState(events.StateRunning, "Running")
+
+	runners := []*system.ServiceRunner{failed, healthchecked, running}
+
+	readiness := system.NodeReadiness(runners, []string{"running"})
+	assert.True(t, readiness.Ready)
+	assert.Empty(t, readiness.Reasons)
+	assert.Len(t, readiness.Services, 3)
+
+	assert.Equal(t, "failed", readiness.Services[0].Id)
+	assert.False(t, readiness.Services[0].Required)
+	assert.Equal(t, "exit status 1", readiness.Services[0].Message)
+	assert.True(t, readiness.Services[2].Required)
+
+	readiness = system.NodeReadiness(runners, []string{"running", "failed", "healthchecked", "missing"})
+	assert.False(t, readiness.Ready)
+	assert.Equal(t, []string{
+		`service "failed" is not running: state Failed: exit status 1`,
+		`service "healthchecked" health is not known yet`,
+		`service "missing" is not registered`,
+	}, readiness.Reasons)
+}
@@ -7,13 +7,22 @@ package restart
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 )
 
+// Counter is implemented by runners which count restarts of the wrapped runner.
+type Counter interface {
+	Restarts() uint64
+}
+
 type restarter struct {
+	// restarts is accessed atomically, keep it first for 64-bit alignment
+	restarts uint64
+
 	wrappedRunner runner.Runner
 	opts          *Options
 
@@ -152,9 +161,16 @@ func (r *restarter) Run(eventSink events.Recorder) error {
 			return nil
 		case <-time.After(r.opts.RestartInterval):
 		}
+
+		atomic.AddUint64(&r.restarts, 1)
 	}
 }
 
+// Restarts implements the Counter interface.
+func (r *restarter) Restarts() uint64 {
+	return atomic.LoadUint64(&r.restarts)
+}
+
 // Stop implements the Runner interface.
 func (r *restarter) Stop() error {
 	close(r.stop)
@@ -143,6 +143,7 @@ func (suite *RestartSuite) TestRunUntilSuccess() {
 	suite.Assert().NoError(<-errCh)
 	suite.Assert().NoError(r.Stop())
 	suite.Assert().Equal(4, mock.times)
+	suite.Assert().EqualValues(3, r.(restart.Counter).Restarts())
 }
 
 func (suite *RestartSuite) TestRunForever() {
@@ -15,6 +15,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
 	"github.com/talos-systems/talos/pkg/conditions"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
@@ -38,6 +39,8 @@ type ServiceRunner struct {
 
 	healthState health.State
 
+	runnr runner.Runner
+
 	stateSubscribers map[StateEvent][]chan<- struct{}
 
 	ctxMu     sync.Mutex
@@ -245,6 +248,10 @@ func (svcrunner *ServiceRunner) Start() {
 		return
 	}
 
+	svcrunner.mu.Lock()
+	svcrunner.runnr = runnr
+	svcrunner.mu.Unlock()
+
 	if err := svcrunner.run(ctx, runnr); err != nil {
 		svcrunner.UpdateState(events.StateFailed, "Failed running service: %v", err)
 	} else {
@@ -359,6 +366,20 @@ func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
 	}
 }
 
+// GetRestarts returns the number of times the service was restarted by the restart policy.
+//
+// Counter is reset when the service is started again via the API.
+func (svcrunner *ServiceRunner) GetRestarts() uint64 {
+	svcrunner.mu.Lock()
+	defer svcrunner.mu.Unlock()
+
+	if counter, ok := svcrunner.runnr.(restart.Counter); ok {
+		return counter.Restarts()
+	}
+
+	return 0
+}
+
 // Subscribe to a specific event for this service.
 //
 // Channel `ch` should be buffered or it should have listener attached to it,
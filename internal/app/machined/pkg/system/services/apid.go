@@ -25,7 +25,6 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
-	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/kubernetes"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -43,7 +42,7 @@ func (o *APID) ID(r runtime.Runtime) string {
 
 // PreFunc implements the Service interface.
 func (o *APID) PreFunc(ctx context.Context, r runtime.Runtime) error {
-	return image.Import(ctx, "/usr/images/apid.tar", "talos/apid")
+	return importSystemImage(ctx, "/usr/images/apid.tar", "talos/apid")
 }
 
 // PostFunc implements the Service interface.
@@ -70,40 +69,17 @@ func (o *APID) DependsOn(r runtime.Runtime) []string {
 }
 
 // Runner implements the Service interface.
-//
-//nolint: gocyclo
 func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 	image := "talos/apid"
 
-	endpoints := []string{"127.0.0.1"}
-
 	// Ensure socket dir exists
 	if err := os.MkdirAll(filepath.Dir(constants.APISocketPath), 0o750); err != nil {
 		return nil, err
 	}
 
-	if r.Config().Machine().Type() == machine.TypeJoin {
-		opts := []retry.Option{retry.WithUnits(3 * time.Second), retry.WithJitter(time.Second)}
-
-		err := retry.Constant(4*time.Minute, opts...).Retry(func() error {
-			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
-			defer ctxCancel()
-
-			h, err := kubernetes.NewClientFromKubeletKubeconfig()
-			if err != nil {
-				return retry.ExpectedError(fmt.Errorf("failed to create client: %w", err))
-			}
-
-			endpoints, err = h.MasterIPs(ctx)
-			if err != nil {
-				return retry.ExpectedError(err)
-			}
-
-			return nil
-		})
-		if err != nil {
-			return nil, err
-		}
+	endpoints, err := trustdEndpoints(r)
+	if err != nil {
+		return nil, err
 	}
 
 	// Set the process arguments.
@@ -180,3 +156,36 @@ func (o *APID) HealthFunc(runtime.Runtime) health.Check {
 func (o *APID) HealthSettings(runtime.Runtime) *health.Settings {
 	return &health.DefaultSettings
 }
+
+// trustdEndpoints returns the list of trustd endpoints used to issue certificates.
+//
+// Control plane nodes use local trustd, while join nodes discover control plane nodes
+// via Kubernetes API.
+func trustdEndpoints(r runtime.Runtime) ([]string, error) {
+	if r.Config().Machine().Type() != machine.TypeJoin {
+		return []string{"127.0.0.1"}, nil
+	}
+
+	var endpoints []string
+
+	opts := []retry.Option{retry.WithUnits(3 * time.Second), retry.WithJitter(time.Second)}
+
+	err := retry.Constant(4*time.Minute, opts...).Retry(func() error {
+		ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer ctxCancel()
+
+		h, err := kubernetes.NewClientFromKubeletKubeconfig()
+		if err != nil {
+			return retry.ExpectedError(fmt.Errorf("failed to create client: %w", err))
+		}
+
+		endpoints, err = h.MasterIPs(ctx)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		return nil
+	})
+
+	return endpoints, err
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/process"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -106,3 +107,19 @@ func (c *Containerd) HealthFunc(runtime.Runtime) health.Check {
 func (c *Containerd) HealthSettings(runtime.Runtime) *health.Settings {
 	return &health.DefaultSettings
 }
+
+// importSystemImage imports the image archive into the system containerd.
+func importSystemImage(ctx context.Context, imagePath, indexName string) error {
+	if err := conditions.WaitForFileToExist(constants.SystemContainerdAddress).Wait(ctx); err != nil {
+		return err
+	}
+
+	client, err := containerd.New(constants.SystemContainerdAddress)
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer client.Close()
+
+	return image.Import(ctx, client, imagePath, indexName)
+}
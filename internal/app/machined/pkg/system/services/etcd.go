@@ -79,7 +79,15 @@ func (e *Etcd) PreFunc(ctx context.Context, r runtime.Runtime) (err error) {
 
 	// Pull the image and unpack it.
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
-	if _, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Cluster().Etcd().Image()); err != nil {
+
+	if r.Config().Machine().Install().ImageCache().Enabled() {
+		// the image might have been imported from the image cache
+		_, err = image.PullIfNotPresent(containerdctx, r.Config().Machine().Registries(), client, r.Config().Cluster().Etcd().Image())
+	} else {
+		_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Cluster().Etcd().Image())
+	}
+
+	if err != nil {
 		return fmt.Errorf("failed to pull image %q: %w", r.Config().Cluster().Etcd().Image(), err)
 	}
 
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: golint
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// HealthCheck implements the Service interface. It serves as the concrete type with
+// the required methods.
+//
+// HealthCheck service doesn't run any process, it only exposes custom health check
+// declared in the machine configuration as the service health.
+type HealthCheck struct {
+	Check config.HealthCheck
+}
+
+// ID implements the Service interface.
+func (h *HealthCheck) ID(r runtime.Runtime) string {
+	return system.HealthCheckServiceID(h.Check.Name())
+}
+
+// PreFunc implements the Service interface.
+func (h *HealthCheck) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (h *HealthCheck) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (h *HealthCheck) Condition(r runtime.Runtime) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (h *HealthCheck) DependsOn(r runtime.Runtime) []string {
+	if exec := h.Check.Exec(); exec != nil {
+		if exec.Namespace() == constants.SystemContainerdNamespace {
+			return []string{"containerd"}
+		}
+
+		return []string{"cri"}
+	}
+
+	return nil
+}
+
+// Runner implements the Service interface.
+func (h *HealthCheck) Runner(r runtime.Runtime) (runner.Runner, error) {
+	return restart.New(goroutine.NewRunner(r, h.ID(r), h.main, runner.WithLoggingManager(r.Logging())),
+		restart.WithType(restart.Forever),
+	), nil
+}
+
+func (h *HealthCheck) main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
+	fmt.Fprintf(logWriter, "running health check %q every %s\n", h.Check.Name(), h.Check.Interval()) //nolint: errcheck
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// HealthFunc implements the HealthcheckedService interface.
+func (h *HealthCheck) HealthFunc(runtime.Runtime) health.Check {
+	switch {
+	case h.Check.HTTP() != nil:
+		return health.HTTPCheck(h.Check.HTTP().URL())
+	case h.Check.TCP() != nil:
+		return health.TCPCheck(h.Check.TCP().Address())
+	case h.Check.Exec() != nil:
+		address := constants.ContainerdAddress
+
+		if h.Check.Exec().Namespace() == constants.SystemContainerdNamespace {
+			address = constants.SystemContainerdAddress
+		}
+
+		return health.ExecCheck(address, h.Check.Exec().Namespace(), h.Check.Exec().Container(), h.Check.Exec().Command())
+	default:
+		return func(context.Context) error {
+			return fmt.Errorf("health check %q is not defined", h.Check.Name())
+		}
+	}
+}
+
+// HealthSettings implements the HealthcheckedService interface.
+func (h *HealthCheck) HealthSettings(runtime.Runtime) *health.Settings {
+	return &health.Settings{
+		InitialDelay:     time.Second,
+		Period:           h.Check.Interval(),
+		Timeout:          h.Check.Timeout(),
+		FailureThreshold: h.Check.FailureThreshold(),
+	}
+}
@@ -112,7 +112,13 @@ func (k *Kubelet) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	// Pull the image and unpack it.
 	containerdctx := namespaces.WithNamespace(ctx, "k8s.io")
 
-	_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Machine().Kubelet().Image())
+	if r.Config().Machine().Install().ImageCache().Enabled() {
+		// cached images are imported at boot, see constants.ImageCacheImportedMarker
+		_, err = image.PullIfNotPresent(containerdctx, r.Config().Machine().Registries(), client, r.Config().Machine().Kubelet().Image())
+	} else {
+		_, err = image.Pull(containerdctx, r.Config().Machine().Registries(), client, r.Config().Machine().Kubelet().Image())
+	}
+
 	if err != nil {
 		return err
 	}
@@ -127,6 +133,11 @@ func (k *Kubelet) PostFunc(r runtime.Runtime, state events.ServiceState) (err er
 
 // Condition implements the Service interface.
 func (k *Kubelet) Condition(r runtime.Runtime) conditions.Condition {
+	if r.State().Platform().Mode() != runtime.ModeContainer && r.Config().Machine().Install().ImageCache().Enabled() {
+		// wait for the cached images to be imported into CRI containerd
+		return conditions.WaitForFileToExist(constants.ImageCacheImportedMarker)
+	}
+
 	return nil
 }
 
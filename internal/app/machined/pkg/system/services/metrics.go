@@ -0,0 +1,527 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// nolint: golint
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+	"github.com/talos-systems/talos/pkg/conditions"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// diskSectorSize is the size of the sector as reported in /proc/diskstats.
+const diskSectorSize = 512
+
+// nodeStats is the part of the machine API which provides node stats reported as metrics.
+type nodeStats interface {
+	LoadAvg(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.LoadAvgResponse, error)
+	Memory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.MemoryResponse, error)
+	SystemStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.SystemStatResponse, error)
+	CPUInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.CPUInfoResponse, error)
+	DiskStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.DiskStatsResponse, error)
+	NetworkDeviceStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.NetworkDeviceStatsResponse, error)
+}
+
+type metricsService struct {
+	stats  nodeStats
+	logger *log.Logger
+}
+
+// Main is an entrypoint to the metrics service.
+func (s *metricsService) Main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
+	s.logger = log.New(logWriter, "", log.LstdFlags)
+
+	// node stats are served by machined API
+	conn, err := grpc.DialContext(ctx, "unix:"+constants.MachineSocketPath, grpc.WithInsecure())
+	if err != nil {
+		return fmt.Errorf("failed to connect to machined: %w", err)
+	}
+
+	defer conn.Close() //nolint: errcheck
+
+	s.stats = machineapi.NewMachineServiceClient(conn)
+
+	endpoints, err := trustdEndpoints(r)
+	if err != nil {
+		return fmt.Errorf("failed to discover trustd endpoints: %w", err)
+	}
+
+	tlsConfig, err := provider.NewTLSConfig(r.Config(), endpoints)
+	if err != nil {
+		return fmt.Errorf("failed to create TLS config: %w", err)
+	}
+
+	serverTLSConfig, err := tlsConfig.ServerConfig()
+	if err != nil {
+		return fmt.Errorf("failed to create server TLS config: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", r.Config().Machine().Metrics().BindAddress())
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:  s.handler(r),
+		ErrorLog: s.logger,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.Serve(tls.NewListener(listener, serverTLSConfig))
+	}()
+
+	s.logger.Printf("serving metrics on %s", listener.Addr())
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+
+		return server.Shutdown(shutdownCtx)
+	case err = <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return err
+	}
+}
+
+func (s *metricsService) handler(r runtime.Runtime) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, req *http.Request) {
+		s.serveMetrics(req.Context(), r, w)
+	})
+
+	return mux
+}
+
+func (s *metricsService) serveMetrics(ctx context.Context, r runtime.Runtime, w http.ResponseWriter) {
+	var buf bytes.Buffer
+
+	mw := metrics.NewWriter(&buf)
+
+	for _, collect := range []func(context.Context, *metrics.Writer) error{
+		s.collectLoadAvg,
+		s.collectMemory,
+		s.collectSystemStat,
+		s.collectCPUInfo,
+		s.collectDiskStats,
+		s.collectNetworkDeviceStats,
+	} {
+		if err := collect(ctx, mw); err != nil {
+			s.logger.Printf("error collecting metrics: %s", err)
+		}
+	}
+
+	collectServices(r, mw)
+	collectRegistryEndpoints(mw)
+
+	if err := mw.Flush(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", metrics.ContentType)
+
+	// nolint: errcheck
+	w.Write(buf.Bytes())
+}
+
+func (s *metricsService) collectLoadAvg(ctx context.Context, mw *metrics.Writer) error {
+	resp, err := s.stats.LoadAvg(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("load average: %w", err)
+	}
+
+	mw.Family("talos_load_average", metrics.Gauge, "System load average.")
+
+	for _, msg := range resp.Messages {
+		mw.Sample("talos_load_average", msg.Load1, "period", "1m")
+		mw.Sample("talos_load_average", msg.Load5, "period", "5m")
+		mw.Sample("talos_load_average", msg.Load15, "period", "15m")
+	}
+
+	return nil
+}
+
+func (s *metricsService) collectMemory(ctx context.Context, mw *metrics.Writer) error {
+	resp, err := s.stats.Memory(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("memory: %w", err)
+	}
+
+	mw.Family("talos_memory_bytes", metrics.Gauge, "Memory usage as reported in /proc/meminfo.")
+
+	for _, msg := range resp.Messages {
+		info := msg.Meminfo
+		if info == nil {
+			continue
+		}
+
+		for _, sample := range []struct {
+			kind  string
+			value uint64
+		}{
+			{"total", info.Memtotal},
+			{"free", info.Memfree},
+			{"available", info.Memavailable},
+			{"buffers", info.Buffers},
+			{"cached", info.Cached},
+			{"active", info.Active},
+			{"inactive", info.Inactive},
+			{"shmem", info.Shmem},
+			{"slab", info.Slab},
+			{"swap_total", info.Swaptotal},
+			{"swap_free", info.Swapfree},
+		} {
+			// meminfo values are in kilobytes
+			mw.Sample("talos_memory_bytes", float64(sample.value*1024), "type", sample.kind)
+		}
+	}
+
+	return nil
+}
+
+func (s *metricsService) collectSystemStat(ctx context.Context, mw *metrics.Writer) error {
+	resp, err := s.stats.SystemStat(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("system stat: %w", err)
+	}
+
+	mw.Family("talos_cpu_seconds_total", metrics.Counter, "Seconds CPUs spent in each mode.")
+
+	for _, msg := range resp.Messages {
+		for i, cpu := range msg.Cpu {
+			id := strconv.Itoa(i)
+
+			for _, sample := range []struct {
+				mode  string
+				value float64
+			}{
+				{"user", cpu.User},
+				{"nice", cpu.Nice},
+				{"system", cpu.System},
+				{"idle", cpu.Idle},
+				{"iowait", cpu.Iowait},
+				{"irq", cpu.Irq},
+				{"softirq", cpu.SoftIrq},
+				{"steal", cpu.Steal},
+			} {
+				mw.Sample("talos_cpu_seconds_total", sample.value, "cpu", id, "mode", sample.mode)
+			}
+		}
+	}
+
+	mw.Family("talos_boot_time_seconds", metrics.Gauge, "System boot time in seconds since epoch.")
+
+	for _, msg := range resp.Messages {
+		mw.Sample("talos_boot_time_seconds", float64(msg.BootTime))
+	}
+
+	mw.Family("talos_context_switches_total", metrics.Counter, "Total number of context switches.")
+
+	for _, msg := range resp.Messages {
+		mw.Sample("talos_context_switches_total", float64(msg.ContextSwitches))
+	}
+
+	mw.Family("talos_processes_created_total", metrics.Counter, "Total number of processes created.")
+
+	for _, msg := range resp.Messages {
+		mw.Sample("talos_processes_created_total", float64(msg.ProcessCreated))
+	}
+
+	mw.Family("talos_processes", metrics.Gauge, "Number of processes by state.")
+
+	for _, msg := range resp.Messages {
+		mw.Sample("talos_processes", float64(msg.ProcessRunning), "state", "running")
+		mw.Sample("talos_processes", float64(msg.ProcessBlocked), "state", "blocked")
+	}
+
+	return nil
+}
+
+func (s *metricsService) collectCPUInfo(ctx context.Context, mw *metrics.Writer) error {
+	resp, err := s.stats.CPUInfo(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("cpu info: %w", err)
+	}
+
+	mw.Family("talos_cpu_info", metrics.Gauge, "CPU information.")
+
+	for _, msg := range resp.Messages {
+		for _, info := range msg.CpuInfo {
+			mw.Sample("talos_cpu_info", 1,
+				"cpu", strconv.Itoa(int(info.Processor)),
+				"vendor", info.VendorId,
+				"family", info.CpuFamily,
+				"model", info.Model,
+				"model_name", info.ModelName,
+				"core", info.CoreId,
+				"package", info.PhysicalId,
+			)
+		}
+	}
+
+	mw.Family("talos_cpu_frequency_hertz", metrics.Gauge, "Current CPU frequency.")
+
+	for _, msg := range resp.Messages {
+		for _, info := range msg.CpuInfo {
+			mw.Sample("talos_cpu_frequency_hertz", info.CpuMhz*1e6, "cpu", strconv.Itoa(int(info.Processor)))
+		}
+	}
+
+	return nil
+}
+
+func (s *metricsService) collectDiskStats(ctx context.Context, mw *metrics.Writer) error {
+	resp, err := s.stats.DiskStats(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("disk stats: %w", err)
+	}
+
+	for _, family := range []struct {
+		name  string
+		typ   metrics.Type
+		help  string
+		value func(stat *machineapi.DiskStat) float64
+	}{
+		{
+			"talos_disk_read_bytes_total", metrics.Counter, "Total number of bytes read.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.ReadSectors * diskSectorSize) },
+		},
+		{
+			"talos_disk_reads_completed_total", metrics.Counter, "Total number of reads completed.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.ReadCompleted) },
+		},
+		{
+			"talos_disk_read_time_seconds_total", metrics.Counter, "Total number of seconds spent by all reads.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.ReadTimeMs) / 1000 },
+		},
+		{
+			"talos_disk_written_bytes_total", metrics.Counter, "Total number of bytes written.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.WriteSectors * diskSectorSize) },
+		},
+		{
+			"talos_disk_writes_completed_total", metrics.Counter, "Total number of writes completed.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.WriteCompleted) },
+		},
+		{
+			"talos_disk_write_time_seconds_total", metrics.Counter, "Total number of seconds spent by all writes.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.WriteTimeMs) / 1000 },
+		},
+		{
+			"talos_disk_io_now", metrics.Gauge, "Number of I/Os currently in progress.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.IoInProgress) },
+		},
+		{
+			"talos_disk_io_time_seconds_total", metrics.Counter, "Total seconds spent doing I/Os.",
+			func(stat *machineapi.DiskStat) float64 { return float64(stat.IoTimeMs) / 1000 },
+		},
+	} {
+		mw.Family(family.name, family.typ, family.help)
+
+		for _, msg := range resp.Messages {
+			for _, stat := range msg.Devices {
+				mw.Sample(family.name, family.value(stat), "device", stat.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *metricsService) collectNetworkDeviceStats(ctx context.Context, mw *metrics.Writer) error {
+	resp, err := s.stats.NetworkDeviceStats(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("network device stats: %w", err)
+	}
+
+	for _, family := range []struct {
+		name  string
+		help  string
+		value func(stat *machineapi.NetDev) uint64
+	}{
+		{"talos_network_receive_bytes_total", "Total number of bytes received.", func(stat *machineapi.NetDev) uint64 { return stat.RxBytes }},
+		{"talos_network_receive_packets_total", "Total number of packets received.", func(stat *machineapi.NetDev) uint64 { return stat.RxPackets }},
+		{"talos_network_receive_errors_total", "Total number of receive errors.", func(stat *machineapi.NetDev) uint64 { return stat.RxErrors }},
+		{"talos_network_receive_drop_total", "Total number of received packets dropped.", func(stat *machineapi.NetDev) uint64 { return stat.RxDropped }},
+		{"talos_network_transmit_bytes_total", "Total number of bytes transmitted.", func(stat *machineapi.NetDev) uint64 { return stat.TxBytes }},
+		{"talos_network_transmit_packets_total", "Total number of packets transmitted.", func(stat *machineapi.NetDev) uint64 { return stat.TxPackets }},
+		{"talos_network_transmit_errors_total", "Total number of transmit errors.", func(stat *machineapi.NetDev) uint64 { return stat.TxErrors }},
+		{"talos_network_transmit_drop_total", "Total number of transmitted packets dropped.", func(stat *machineapi.NetDev) uint64 { return stat.TxDropped }},
+	} {
+		mw.Family(family.name, metrics.Counter, family.help)
+
+		for _, msg := range resp.Messages {
+			for _, stat := range msg.Devices {
+				mw.Sample(family.name, float64(family.value(stat)), "device", stat.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func collectServices(r runtime.Runtime, mw *metrics.Writer) {
+	runners := system.Services(r).List()
+
+	states := []events.ServiceState{
+		events.StateInitialized,
+		events.StatePreparing,
+		events.StateWaiting,
+		events.StateRunning,
+		events.StateStopping,
+		events.StateFinished,
+		events.StateFailed,
+		events.StateSkipped,
+	}
+
+	mw.Family("talos_service_state", metrics.Gauge, "Current state of the service.")
+
+	for _, svcrunner := range runners {
+		info := svcrunner.AsProto()
+
+		for _, state := range states {
+			value := 0.0
+			if state.String() == info.State {
+				value = 1
+			}
+
+			mw.Sample("talos_service_state", value, "service", info.Id, "state", state.String())
+		}
+	}
+
+	mw.Family("talos_service_restarts_total", metrics.Counter, "Number of times the service was restarted.")
+
+	for _, svcrunner := range runners {
+		mw.Sample("talos_service_restarts_total", float64(svcrunner.GetRestarts()), "service", svcrunner.AsProto().Id)
+	}
+
+	mw.Family("talos_service_healthy", metrics.Gauge, "Whether the service is healthy, only reported for services with known health.")
+
+	for _, svcrunner := range runners {
+		info := svcrunner.AsProto()
+
+		if info.Health == nil || info.Health.Unknown {
+			continue
+		}
+
+		value := 0.0
+		if info.Health.Healthy {
+			value = 1
+		}
+
+		mw.Sample("talos_service_healthy", value, "service", info.Id)
+	}
+}
+
+// collectRegistryEndpoints reports registry endpoint stats for the images pulled by machined.
+func collectRegistryEndpoints(mw *metrics.Writer) {
+	stats := image.DefaultEndpointHealth.Stats()
+
+	mw.Family("talos_registry_endpoint_requests_total", metrics.Counter, "Number of requests sent to the registry endpoint.")
+
+	for _, st := range stats {
+		mw.Sample("talos_registry_endpoint_requests_total", float64(st.Requests), "endpoint", st.Endpoint)
+	}
+
+	mw.Family("talos_registry_endpoint_failures_total", metrics.Counter, "Number of failed requests to the registry endpoint.")
+
+	for _, st := range stats {
+		mw.Sample("talos_registry_endpoint_failures_total", float64(st.Failures), "endpoint", st.Endpoint)
+	}
+
+	mw.Family("talos_registry_endpoint_received_bytes_total", metrics.Counter, "Number of bytes received from the registry endpoint.")
+
+	for _, st := range stats {
+		mw.Sample("talos_registry_endpoint_received_bytes_total", float64(st.Bytes), "endpoint", st.Endpoint)
+	}
+
+	mw.Family("talos_registry_endpoint_circuit_open", metrics.Gauge, "Whether the registry endpoint is skipped after consecutive failures.")
+
+	for _, st := range stats {
+		value := 0.0
+		if st.Open {
+			value = 1
+		}
+
+		mw.Sample("talos_registry_endpoint_circuit_open", value, "endpoint", st.Endpoint)
+	}
+}
+
+// Metrics implements the Service interface. It serves as the concrete type with
+// the required methods.
+type Metrics struct{}
+
+// ID implements the Service interface.
+func (m *Metrics) ID(r runtime.Runtime) string {
+	return "metrics"
+}
+
+// PreFunc implements the Service interface.
+func (m *Metrics) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (m *Metrics) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (m *Metrics) Condition(r runtime.Runtime) conditions.Condition {
+	if r.Config().Machine().Type() == machine.TypeJoin {
+		return conditions.WaitForFileToExist(constants.KubeletKubeconfig)
+	}
+
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (m *Metrics) DependsOn(r runtime.Runtime) []string {
+	if r.Config().Machine().Type() == machine.TypeJoin {
+		return []string{"machined", "networkd"}
+	}
+
+	return []string{"machined", "networkd", "trustd"}
+}
+
+// Runner implements the Service interface.
+func (m *Metrics) Runner(r runtime.Runtime) (runner.Runner, error) {
+	svc := &metricsService{}
+
+	return restart.New(goroutine.NewRunner(r, "metrics", svc.Main, runner.WithLoggingManager(r.Logging())),
+		restart.WithType(restart.Forever),
+	), nil
+}
@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+type fakeNodeStats struct {
+	memoryErr error
+}
+
+func (f *fakeNodeStats) LoadAvg(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.LoadAvgResponse, error) {
+	return &machineapi.LoadAvgResponse{
+		Messages: []*machineapi.LoadAvg{{Load1: 0.5, Load5: 0.25, Load15: 0.125}},
+	}, nil
+}
+
+func (f *fakeNodeStats) Memory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.MemoryResponse, error) {
+	if f.memoryErr != nil {
+		return nil, f.memoryErr
+	}
+
+	return &machineapi.MemoryResponse{
+		Messages: []*machineapi.Memory{{Meminfo: &machineapi.MemInfo{Memtotal: 2048, Memfree: 1024}}},
+	}, nil
+}
+
+func (f *fakeNodeStats) SystemStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.SystemStatResponse, error) {
+	return &machineapi.SystemStatResponse{
+		Messages: []*machineapi.SystemStat{
+			{
+				BootTime:       1000,
+				Cpu:            []*machineapi.CPUStat{{User: 10, System: 5, Idle: 100}},
+				ProcessRunning: 3,
+			},
+		},
+	}, nil
+}
+
+func (f *fakeNodeStats) CPUInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.CPUInfoResponse, error) {
+	return &machineapi.CPUInfoResponse{
+		Messages: []*machineapi.CPUsInfo{{CpuInfo: []*machineapi.CPUInfo{{Processor: 0, ModelName: "Test CPU", CpuMhz: 2000}}}},
+	}, nil
+}
+
+func (f *fakeNodeStats) DiskStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.DiskStatsResponse, error) {
+	return &machineapi.DiskStatsResponse{
+		Messages: []*machineapi.DiskStats{{Devices: []*machineapi.DiskStat{{Name: "sda", ReadSectors: 2, ReadTimeMs: 1500}}}},
+	}, nil
+}
+
+func (f *fakeNodeStats) NetworkDeviceStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*machineapi.NetworkDeviceStatsResponse, error) {
+	return &machineapi.NetworkDeviceStatsResponse{
+		Messages: []*machineapi.NetworkDeviceStats{{Devices: []*machineapi.NetDev{{Name: "eth0", RxBytes: 100, TxPackets: 7}}}},
+	}, nil
+}
+
+func scrape(t *testing.T, stats nodeStats) string {
+	svc := &metricsService{
+		stats:  stats,
+		logger: log.New(ioutil.Discard, "", 0),
+	}
+
+	srv := httptest.NewServer(svc.handler(nil))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	require.NoError(t, err)
+
+	defer resp.Body.Close() //nolint: errcheck
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, metrics.ContentType, resp.Header.Get("Content-Type"))
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestMetricsScrape(t *testing.T) {
+	body := scrape(t, &fakeNodeStats{})
+
+	for _, line := range []string{
+		"# TYPE talos_load_average gauge",
+		`talos_load_average{period="1m"} 0.5`,
+		`talos_load_average{period="15m"} 0.125`,
+		`talos_memory_bytes{type="total"} 2.097152e+06`,
+		"# TYPE talos_cpu_seconds_total counter",
+		`talos_cpu_seconds_total{cpu="0",mode="user"} 10`,
+		`talos_cpu_seconds_total{cpu="0",mode="idle"} 100`,
+		"talos_boot_time_seconds 1000",
+		`talos_processes{state="running"} 3`,
+		`talos_cpu_frequency_hertz{cpu="0"} 2e+09`,
+		`talos_disk_read_bytes_total{device="sda"} 1024`,
+		`talos_disk_read_time_seconds_total{device="sda"} 1.5`,
+		`talos_network_receive_bytes_total{device="eth0"} 100`,
+		`talos_network_transmit_packets_total{device="eth0"} 7`,
+		"# TYPE talos_service_state gauge",
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+}
+
+func TestMetricsScrapePartialFailure(t *testing.T) {
+	body := scrape(t, &fakeNodeStats{memoryErr: errors.New("meminfo is not available")})
+
+	// failed collector is skipped, the rest of the metrics are still reported
+	assert.NotContains(t, body, "talos_memory_bytes")
+	assert.Contains(t, body, `talos_load_average{period="1m"} 0.5`+"\n")
+	assert.Contains(t, body, `talos_network_receive_bytes_total{device="eth0"} 100`+"\n")
+}
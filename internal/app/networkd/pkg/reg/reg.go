@@ -15,11 +15,13 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 
 	"github.com/talos-systems/talos/internal/app/networkd/pkg/networkd"
 	healthapi "github.com/talos-systems/talos/pkg/machinery/api/health"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	networkapi "github.com/talos-systems/talos/pkg/machinery/api/network"
 )
 
@@ -96,6 +98,75 @@ func (r *Registrator) Interfaces(ctx context.Context, in *empty.Empty) (reply *n
 	return networkd.GetDevices()
 }
 
+// Events streams the changes of the addresses configured on the host interfaces.
+func (r *Registrator) Events(in *empty.Empty, srv networkapi.NetworkService_EventsServer) error {
+	conn, err := rtnetlink.Dial(&netlink.Config{
+		Groups: unix.RTMGRP_IPV4_IFADDR | unix.RTMGRP_IPV6_IFADDR,
+	})
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink: %w", err)
+	}
+
+	go func() {
+		<-srv.Context().Done()
+
+		conn.Close() //nolint: errcheck
+	}()
+
+	for {
+		msgs, omsgs, recvErr := conn.Receive()
+		if recvErr != nil {
+			if srv.Context().Err() != nil {
+				return srv.Context().Err()
+			}
+
+			return fmt.Errorf("error receiving rtnetlink messages: %w", recvErr)
+		}
+
+		for i, msg := range msgs {
+			event := addressEvent(msg, omsgs[i].Header.Type)
+			if event == nil {
+				continue
+			}
+
+			if err = srv.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// addressEvent converts rtnetlink address message to the event, nil is returned for other messages.
+func addressEvent(msg rtnetlink.Message, typ netlink.HeaderType) *machine.AddressEvent {
+	addr, ok := msg.(*rtnetlink.AddressMessage)
+	if !ok {
+		return nil
+	}
+
+	var action machine.AddressEvent_Action
+
+	switch typ {
+	case unix.RTM_NEWADDR:
+		action = machine.AddressEvent_ADDED
+	case unix.RTM_DELADDR:
+		action = machine.AddressEvent_REMOVED
+	default:
+		return nil
+	}
+
+	link := addr.Attributes.Label
+
+	if iface, err := net.InterfaceByIndex(int(addr.Index)); err == nil {
+		link = iface.Name
+	}
+
+	return &machine.AddressEvent{
+		Link:    link,
+		Address: fmt.Sprintf("%s/%d", addr.Attributes.Address, addr.PrefixLength),
+		Action:  action,
+	}
+}
+
 func toCIDR(family uint8, prefix net.IP, prefixLen int) string {
 	netLen := 32
 
@@ -35,6 +35,7 @@ func main() {
 	router.RegisterLocalBackend("time.TimeService", backend.NewLocal("timed", constants.TimeSocketPath))
 	router.RegisterLocalBackend("network.NetworkService", backend.NewLocal("networkd", constants.NetworkSocketPath))
 	router.RegisterLocalBackend("cluster.ClusterService", machinedBackend)
+	router.RegisterLocalBackend("health.NodeHealthService", machinedBackend)
 
 	err := factory.ListenAndServe(
 		router,
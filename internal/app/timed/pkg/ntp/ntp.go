@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -30,6 +31,10 @@ type NTP struct {
 
 	ready    uint32
 	rtcClock *rtc.RTC
+
+	syncMu      sync.Mutex
+	synced      bool
+	syncChanged chan struct{}
 }
 
 // NewNTPClient instantiates a new ntp client for the
@@ -57,6 +62,30 @@ func (n *NTP) Ready() bool {
 	return atomic.LoadUint32(&n.ready) > 0
 }
 
+// SyncStatus returns whether the last time sync was successful.
+//
+// Returned channel is closed when the status changes.
+func (n *NTP) SyncStatus() (bool, <-chan struct{}) {
+	n.syncMu.Lock()
+	defer n.syncMu.Unlock()
+
+	return n.synced, n.syncChanged
+}
+
+func (n *NTP) setSynced(synced bool) {
+	n.syncMu.Lock()
+	defer n.syncMu.Unlock()
+
+	if n.synced == synced {
+		return
+	}
+
+	n.synced = synced
+
+	close(n.syncChanged)
+	n.syncChanged = make(chan struct{})
+}
+
 // Daemon runs the control loop for query and set time
 // We dont ever want the daemon to stop, so we only log
 // errors.
@@ -120,6 +149,10 @@ func (n *NTP) GetTime() time.Time {
 func (n *NTP) QueryAndSetTime(ctx context.Context) (err error) {
 	var resp *ntp.Response
 
+	defer func() {
+		n.setSynced(err == nil)
+	}()
+
 	if resp, err = n.Query(ctx); err != nil {
 		return fmt.Errorf("error querying %s for time, %s", n.Server, err)
 	}
@@ -28,6 +28,8 @@ func defaultOptions() *NTP {
 		Server:  "pool.ntp.org",
 		MaxPoll: MaxAllowablePoll * time.Second,
 		MinPoll: 64 * time.Second,
+
+		syncChanged: make(chan struct{}),
 	}
 }
 
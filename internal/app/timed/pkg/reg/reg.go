@@ -15,6 +15,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/timed/pkg/ntp"
 	healthapi "github.com/talos-systems/talos/pkg/machinery/api/health"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	timeapi "github.com/talos-systems/talos/pkg/machinery/api/time"
 )
 
@@ -66,6 +67,27 @@ func (r *Registrator) TimeCheck(ctx context.Context, in *timeapi.TimeRequest) (r
 	return genProtobufTimeResponse(tc.GetTime(), rt.Time, in.Server)
 }
 
+// Events streams the changes of the time synchronization status.
+//
+// Current status is sent first.
+func (r *Registrator) Events(in *empty.Empty, srv timeapi.TimeService_EventsServer) error {
+	for {
+		synced, changed := r.Timed.SyncStatus()
+
+		if err := srv.Send(&machine.TimeSyncEvent{
+			Synced: synced,
+		}); err != nil {
+			return err
+		}
+
+		select {
+		case <-srv.Context().Done():
+			return srv.Context().Err()
+		case <-changed:
+		}
+	}
+}
+
 func genProtobufTimeResponse(local, remote time.Time, server string) (*timeapi.TimeResponse, error) {
 	resp := &timeapi.TimeResponse{}
 
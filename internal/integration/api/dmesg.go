@@ -13,7 +13,7 @@ import (
 	"time"
 
 	"github.com/talos-systems/talos/internal/integration/base"
-	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
@@ -52,7 +52,7 @@ func (suite *DmesgSuite) TestNodeHasDmesg() {
 	)
 	suite.Require().NoError(err)
 
-	logReader, errCh, err := client.ReadStream(dmesgStream)
+	logReader, errCh, err := client.ReadStream(client.DmesgDataStream(dmesgStream))
 	suite.Require().NoError(err)
 
 	n, err := io.Copy(ioutil.Discard, logReader)
@@ -75,7 +75,7 @@ func (suite *DmesgSuite) TestStreaming() {
 
 	suite.Require().NoError(dmesgStream.CloseSend())
 
-	respCh := make(chan *common.Data)
+	respCh := make(chan *machine.DmesgResponse)
 	errCh := make(chan error, 1)
 
 	go func() {
@@ -118,6 +118,30 @@ DrainLoop:
 	suite.Assert().Greater(logCount, 10)
 }
 
+// TestFilter verifies that messages are filtered by level and facility.
+func (suite *DmesgSuite) TestFilter() {
+	dmesgStream, err := suite.Client.MachineClient.Dmesg(suite.ctx, &machine.DmesgRequest{
+		Level:      "notice",
+		Facilities: []string{"kern"},
+	})
+	suite.Require().NoError(err)
+
+	for {
+		msg, err := dmesgStream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			suite.Require().NoError(err)
+		}
+
+		suite.Assert().Equal("kern", msg.Facility)
+		suite.Assert().NotContains([]string{"info", "debug"}, msg.Priority)
+		suite.Assert().NotNil(msg.Timestamp)
+	}
+}
+
 // TestClusterHasDmesg verifies that all the cluster nodes have dmesg.
 func (suite *DmesgSuite) TestClusterHasDmesg() {
 	nodes := suite.DiscoverNodes().Nodes()
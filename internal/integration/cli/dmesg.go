@@ -7,6 +7,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -46,6 +47,33 @@ func (suite *DmesgSuite) TestClusterHasOutput() {
 		matchers...)
 }
 
+// TestJSONOutput verifies that dmesg is displayed as filtered JSON messages.
+func (suite *DmesgSuite) TestJSONOutput() {
+	suite.RunCLI([]string{"dmesg", "--nodes", suite.RandomDiscoveredNode(), "--level", "info", "--output", "json"},
+		base.StdoutMatchFunc(func(stdout string) error {
+			for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+				var msg struct {
+					Priority  string `json:"priority"`
+					Timestamp string `json:"timestamp"`
+				}
+
+				if err := json.Unmarshal([]byte(line), &msg); err != nil {
+					return fmt.Errorf("error parsing %q: %w", line, err)
+				}
+
+				if msg.Priority == "debug" {
+					return fmt.Errorf("unexpected message priority: %q", line)
+				}
+
+				if msg.Timestamp == "" {
+					return fmt.Errorf("message timestamp is missing: %q", line)
+				}
+			}
+
+			return nil
+		}))
+}
+
 func init() {
 	allSuites = append(allSuites, new(DmesgSuite))
 }
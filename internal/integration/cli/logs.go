@@ -49,6 +49,47 @@ func (suite *LogsSuite) TestTailLogs() {
 		}))
 }
 
+// TestQueryLogs verifies that logs of several services can be filtered and interleaved.
+func (suite *LogsSuite) TestQueryLogs() {
+	node := suite.RandomDiscoveredNode()
+
+	// each API call is logged with the method name
+	const method = "/machine.MachineService/Version"
+
+	for i := 0; i < 5; i++ {
+		suite.RunCLI([]string{"-n", node, "version"})
+	}
+
+	suite.RunCLI([]string{"logs", "machined", "apid", "-n", node, "--since", "10m", "--grep", method, "--tail", "5"},
+		base.StdoutMatchFunc(func(stdout string) error {
+			lines := strings.Split(strings.TrimSpace(stdout), "\n")
+			if len(lines) != 5 {
+				return fmt.Errorf("expected %d lines, found %d lines", 5, len(lines))
+			}
+
+			for _, line := range lines {
+				if !strings.Contains(line, method) {
+					return fmt.Errorf("line %q doesn't match the filter", line)
+				}
+
+				if !strings.Contains(line, ": machined: ") && !strings.Contains(line, ": apid: ") {
+					return fmt.Errorf("line %q is not prefixed with the service name", line)
+				}
+			}
+
+			return nil
+		}))
+}
+
+// TestQueryInvalidRegex verifies that invalid grep expressions are rejected.
+func (suite *LogsSuite) TestQueryInvalidRegex() {
+	suite.RunCLI([]string{"logs", "--nodes", suite.RandomDiscoveredNode(), "apid", "--grep", "[", "--grep-regex"},
+		base.StdoutEmpty(),
+		base.StderrNotEmpty(),
+		base.StderrShouldMatch(regexp.MustCompile(`error compiling grep expression`)),
+	)
+}
+
 // TestServiceNotFound verifies that logs displays an error if service is not found.
 func (suite *LogsSuite) TestServiceNotFound() {
 	suite.RunCLI([]string{"logs", "--nodes", suite.RandomDiscoveredNode(), "servicenotfound"},
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package clientcert issues Talos API client certificates.
+package clientcert
+
+import (
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/talos-systems/crypto/x509"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/identity"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Issue signs the client certificate for the public key of the CSR.
+//
+// Subject and roles of the certificate are taken from the identity of the authenticated client,
+// subject of the CSR is ignored except for the organizations: these request a subset of the client roles,
+// all the client roles are granted if the CSR has no organizations.
+// Subject alternative names of the CSR are ignored as well.
+func Issue(ca *x509.PEMEncodedCertificateAndKey, csrPEM []byte, id *identity.Identity, ttl time.Duration) (*x509.Certificate, error) {
+	if ttl <= 0 || ttl > constants.TalosAdminCertMaxLifetime {
+		return nil, fmt.Errorf("certificate lifetime should be in range (0, %s]", constants.TalosAdminCertMaxLifetime)
+	}
+
+	block, _ := pem.Decode(csrPEM)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode certificate signing request")
+	}
+
+	csr, err := stdx509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate signing request: %w", err)
+	}
+
+	if err = csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("error verifying certificate signing request: %w", err)
+	}
+
+	var requested []string
+
+	for _, role := range csr.Subject.Organization {
+		if role != "" {
+			requested = append(requested, role)
+		}
+	}
+
+	roles := id.Roles
+
+	if len(requested) > 0 {
+		if !id.HasRoles(requested...) {
+			return nil, fmt.Errorf("requested roles %q are not a subset of the client roles %q", requested, id.Roles)
+		}
+
+		roles = requested
+	}
+
+	request := &stdx509.CertificateRequest{
+		SignatureAlgorithm: csr.SignatureAlgorithm,
+		PublicKeyAlgorithm: csr.PublicKeyAlgorithm,
+		PublicKey:          csr.PublicKey,
+		Subject: pkix.Name{
+			CommonName:   id.CommonName,
+			Organization: roles,
+		},
+	}
+
+	authority, err := x509.NewCertificateAuthorityFromCertificateAndKey(ca)
+	if err != nil {
+		return nil, fmt.Errorf("error loading CA: %w", err)
+	}
+
+	now := time.Now()
+
+	return x509.NewCertificateFromCSR(authority.Crt, authority.Key, request, x509.NotBefore(now), x509.NotAfter(now.Add(ttl)))
+}
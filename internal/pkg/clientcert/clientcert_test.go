@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package clientcert_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
+
+	"github.com/talos-systems/talos/internal/pkg/clientcert"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/identity"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func TestIssue(t *testing.T) {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"))
+	require.NoError(t, err)
+
+	pemCA := &x509.PEMEncodedCertificateAndKey{
+		Crt: ca.CrtPEM,
+		Key: ca.KeyPEM,
+	}
+
+	id := &identity.Identity{
+		CommonName: "admin",
+		Roles:      []string{"os:admin", "os:reader"},
+	}
+
+	csr := func(opts ...x509.Option) []byte {
+		request, _, csrErr := x509.NewEd25519CSRAndIdentity(opts...)
+		require.NoError(t, csrErr)
+
+		return request.X509CertificateRequestPEM
+	}
+
+	// subject and SANs of the CSR are ignored
+	crt, err := clientcert.Issue(pemCA, csr(
+		x509.CommonName("root"),
+		x509.IPAddresses([]net.IP{net.ParseIP("10.5.0.2")}),
+		x509.DNSNames([]string{"kubernetes"}),
+	), id, time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, "admin", crt.X509Certificate.Subject.CommonName)
+	assert.Equal(t, []string{"os:admin", "os:reader"}, crt.X509Certificate.Subject.Organization)
+	assert.Empty(t, crt.X509Certificate.IPAddresses)
+	assert.Empty(t, crt.X509Certificate.DNSNames)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), crt.X509Certificate.NotAfter, time.Minute)
+	assert.NoError(t, crt.X509Certificate.CheckSignatureFrom(ca.Crt))
+
+	// subset of the roles
+	crt, err = clientcert.Issue(pemCA, csr(x509.Organization("os:reader")), id, time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"os:reader"}, crt.X509Certificate.Subject.Organization)
+
+	// privilege escalation
+	_, err = clientcert.Issue(pemCA, csr(x509.Organization("os:root")), id, time.Hour)
+	assert.EqualError(t, err, `requested roles ["os:root"] are not a subset of the client roles ["os:admin" "os:reader"]`)
+
+	// lifetime is capped
+	_, err = clientcert.Issue(pemCA, csr(), id, constants.TalosAdminCertMaxLifetime+time.Hour)
+	assert.EqualError(t, err, "certificate lifetime should be in range (0, 720h0m0s]")
+
+	_, err = clientcert.Issue(pemCA, []byte("garbage"), id, time.Hour)
+	assert.EqualError(t, err, "failed to decode certificate signing request")
+}
@@ -53,6 +53,15 @@ func (suite *ConfigSuite) TestGenerateRegistriesConfig() {
 			"docker.io": {
 				MirrorEndpoints: []string{"https://registry-1.docker.io", "https://registry-2.docker.io"},
 			},
+			"ghcr.io": {
+				MirrorEndpoints:    []string{"http://127.0.0.1:5000/ghcr.io"},
+				MirrorAppendV2Path: true,
+				MirrorSkipFallback: true,
+			},
+			"quay.io": {
+				// upstream registry is appended by CRI, not rendered to the config
+				MirrorEndpoints: []string{"http://127.0.0.1:5001/quay.io"},
+			},
 		},
 		config: map[string]*v1alpha1.RegistryConfig{
 			"some.host:123": {
@@ -102,6 +111,10 @@ func (suite *ConfigSuite) TestGenerateRegistriesConfig() {
       [plugins.cri.registry.mirrors]
         [plugins.cri.registry.mirrors."docker.io"]
           endpoint = ["https://registry-1.docker.io", "https://registry-2.docker.io"]
+        [plugins.cri.registry.mirrors."ghcr.io"]
+          endpoint = ["http://127.0.0.1:5000/ghcr.io/v2"]
+        [plugins.cri.registry.mirrors."quay.io"]
+          endpoint = ["http://127.0.0.1:5001/quay.io"]
       [plugins.cri.registry.configs]
         [plugins.cri.registry.configs."some.host:123"]
           [plugins.cri.registry.configs."some.host:123".auth]
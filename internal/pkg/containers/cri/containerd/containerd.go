@@ -8,10 +8,12 @@ package containerd
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 	"path/filepath"
 
 	"github.com/BurntSushi/toml"
 
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -19,6 +21,10 @@ import (
 
 // GenerateRegistriesConfig returns a list of extra files.
 //
+// Mirror endpoint paths follow the same rules as the Talos image resolver, but the fallback
+// is controlled by CRI: CRI always tries the upstream registry after the mirror endpoints,
+// so `skipFallback` and the endpoint circuit breaking don't apply to the images pulled via CRI.
+//
 //nolint: gocyclo
 func GenerateRegistriesConfig(r config.Registries) ([]config.File, error) {
 	caPath := filepath.Join(filepath.Dir(constants.CRIContainerdConfig), "ca")
@@ -29,7 +35,12 @@ func GenerateRegistriesConfig(r config.Registries) ([]config.File, error) {
 	ctrdCfg.Plugins.CRI.Registry.Configs = make(map[string]RegistryConfig)
 
 	for mirrorName, mirrorConfig := range r.Mirrors() {
-		ctrdCfg.Plugins.CRI.Registry.Mirrors[mirrorName] = Mirror{Endpoints: mirrorConfig.Endpoints()}
+		endpoints, err := mirrorEndpoints(mirrorName, mirrorConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		ctrdCfg.Plugins.CRI.Registry.Mirrors[mirrorName] = Mirror{Endpoints: endpoints}
 	}
 
 	var extraFiles []config.File
@@ -112,3 +123,30 @@ func GenerateRegistriesConfig(r config.Registries) ([]config.File, error) {
 		FileOp:          "append",
 	}), nil
 }
+
+// mirrorEndpoints returns the mirror endpoints for CRI.
+//
+// CRI uses the endpoint path as is, so `/v2` is appended to the path if the mirror asks for it.
+// CRI appends the upstream registry to the endpoints itself.
+func mirrorEndpoints(mirrorName string, mirrorConfig config.RegistryMirrorConfig) ([]string, error) {
+	endpoints := mirrorConfig.Endpoints()
+
+	if !mirrorConfig.AppendV2Path() {
+		return endpoints, nil
+	}
+
+	result := make([]string, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing endpoint %q for host %q: %w", endpoint, mirrorName, err)
+		}
+
+		u.Path = image.EndpointPath(u.Path, true)
+
+		result = append(result, u.String())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// ImportCache imports the OCI archives stored in the image cache partition for the namespace.
+//
+// Image cache partition should be mounted, otherwise the import is skipped.
+func ImportCache(ctx context.Context, client *containerd.Client, namespace string) error {
+	archives, err := filepath.Glob(filepath.Join(constants.ImageCacheMountPoint, namespace, "*.tar"))
+	if err != nil {
+		return err
+	}
+
+	ctx = namespaces.WithNamespace(ctx, namespace)
+
+	var result *multierror.Error
+
+	for _, path := range archives {
+		result = multierror.Append(result, importArchive(ctx, client, path))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// PullIfNotPresent pulls the image unless it's already present in the namespace
+// (e.g. imported from the image cache).
+func PullIfNotPresent(ctx context.Context, reg config.Registries, client *containerd.Client, ref string) (containerd.Image, error) {
+	img, err := client.GetImage(ctx, ref)
+	if err == nil {
+		return img, nil
+	}
+
+	if !errdefs.IsNotFound(err) {
+		return nil, err
+	}
+
+	return Pull(ctx, reg, client, ref)
+}
@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Registry endpoint circuit breaker settings.
+const (
+	EndpointFailureThreshold = 3
+	EndpointCooldown         = time.Minute
+)
+
+// DefaultEndpointHealth is shared by all the resolvers built with NewResolver.
+var DefaultEndpointHealth = NewEndpointHealth(EndpointFailureThreshold, EndpointCooldown)
+
+// EndpointStats is a snapshot of the registry endpoint counters.
+type EndpointStats struct {
+	Endpoint string
+	Requests uint64
+	Failures uint64
+	Bytes    uint64
+	Open     bool
+}
+
+type endpointState struct {
+	requests uint64
+	failures uint64
+	bytes    uint64
+
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+// EndpointHealth tracks registry endpoint failures.
+//
+// Once the endpoint fails threshold times in a row, the circuit opens and
+// the endpoint is skipped for the cooldown period.
+type EndpointHealth struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointState
+}
+
+// NewEndpointHealth initializes EndpointHealth.
+func NewEndpointHealth(threshold int, cooldown time.Duration) *EndpointHealth {
+	return &EndpointHealth{
+		threshold: threshold,
+		cooldown:  cooldown,
+		endpoints: map[string]*endpointState{},
+	}
+}
+
+func (h *EndpointHealth) state(endpoint string) *endpointState {
+	st, ok := h.endpoints[endpoint]
+	if !ok {
+		st = &endpointState{}
+		h.endpoints[endpoint] = st
+	}
+
+	return st
+}
+
+// Available returns false if the circuit is open for the endpoint.
+func (h *EndpointHealth) Available(endpoint string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return !time.Now().Before(h.state(endpoint).openUntil)
+}
+
+// RecordSuccess resets the failure counter for the endpoint.
+func (h *EndpointHealth) RecordSuccess(endpoint string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st := h.state(endpoint)
+	st.requests++
+	st.consecutiveFailures = 0
+	st.openUntil = time.Time{}
+}
+
+// RecordFailure records endpoint failure and opens the circuit once the threshold is reached.
+func (h *EndpointHealth) RecordFailure(endpoint string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st := h.state(endpoint)
+	st.requests++
+	st.failures++
+	st.consecutiveFailures++
+
+	if st.consecutiveFailures >= h.threshold {
+		st.openUntil = time.Now().Add(h.cooldown)
+	}
+}
+
+// RecordBytes records the number of bytes served by the endpoint.
+func (h *EndpointHealth) RecordBytes(endpoint string, n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.state(endpoint).bytes += uint64(n)
+}
+
+// Stats returns the counters for all the known endpoints sorted by endpoint.
+func (h *EndpointHealth) Stats() []EndpointStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	stats := make([]EndpointStats, 0, len(h.endpoints))
+
+	for endpoint, st := range h.endpoints {
+		stats = append(stats, EndpointStats{
+			Endpoint: endpoint,
+			Requests: st.requests,
+			Failures: st.failures,
+			Bytes:    st.bytes,
+			Open:     now.Before(st.openUntil),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Endpoint < stats[j].Endpoint })
+
+	return stats
+}
+
+// endpointRecorder collects the endpoints which served the content during the pull.
+type endpointRecorder struct {
+	mu        sync.Mutex
+	endpoints []string
+}
+
+func (r *endpointRecorder) record(endpoint string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, e := range r.endpoints {
+		if e == endpoint {
+			return
+		}
+	}
+
+	r.endpoints = append(r.endpoints, endpoint)
+}
+
+func (r *endpointRecorder) served() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.endpoints...)
+}
+
+// EndpointTransport is the HTTP transport for the registry endpoint which reports
+// request results to the endpoint health tracker.
+type EndpointTransport struct {
+	*http.Transport
+
+	Endpoint string
+
+	health   *EndpointHealth
+	recorder *endpointRecorder
+}
+
+// RoundTrip implements http.RoundTripper.
+//
+// Network errors and server errors are counted as endpoint failures.
+func (t *EndpointTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) && req.Context().Err() == nil {
+			t.health.RecordFailure(t.Endpoint)
+		}
+
+		return resp, err
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		t.health.RecordFailure(t.Endpoint)
+
+		return resp, nil
+	}
+
+	t.health.RecordSuccess(t.Endpoint)
+
+	if req.Method == http.MethodGet && resp.StatusCode == http.StatusOK {
+		t.recorder.record(t.Endpoint)
+	}
+
+	resp.Body = &countingReader{ReadCloser: resp.Body, health: t.health, endpoint: t.Endpoint}
+
+	return resp, nil
+}
+
+type countingReader struct {
+	io.ReadCloser
+
+	health   *EndpointHealth
+	endpoint string
+	n        int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+
+	r.n += n
+
+	return n, err
+}
+
+func (r *countingReader) Close() error {
+	r.health.RecordBytes(r.endpoint, r.n)
+
+	return r.ReadCloser.Close()
+}
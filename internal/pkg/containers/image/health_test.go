@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+)
+
+type HealthSuite struct {
+	suite.Suite
+}
+
+func (suite *HealthSuite) TestCircuitBreaker() {
+	health := image.NewEndpointHealth(2, time.Hour)
+
+	suite.Assert().True(health.Available("https://some.host/v2"))
+
+	health.RecordFailure("https://some.host/v2")
+	suite.Assert().True(health.Available("https://some.host/v2"))
+
+	health.RecordSuccess("https://some.host/v2")
+	health.RecordFailure("https://some.host/v2")
+	suite.Assert().True(health.Available("https://some.host/v2"))
+
+	health.RecordFailure("https://some.host/v2")
+	suite.Assert().False(health.Available("https://some.host/v2"))
+	suite.Assert().True(health.Available("https://other.host/v2"))
+
+	health.RecordBytes("https://other.host/v2", 42)
+
+	suite.Assert().Equal([]image.EndpointStats{
+		{
+			Endpoint: "https://other.host/v2",
+			Bytes:    42,
+		},
+		{
+			Endpoint: "https://some.host/v2",
+			Requests: 4,
+			Failures: 3,
+			Open:     true,
+		},
+	}, health.Stats())
+}
+
+func (suite *HealthSuite) TestCooldown() {
+	health := image.NewEndpointHealth(1, 0)
+
+	health.RecordFailure("https://some.host/v2")
+	suite.Assert().True(health.Available("https://some.host/v2"))
+}
+
+func TestHealthSuite(t *testing.T) {
+	suite.Run(t, new(HealthSuite))
+}
@@ -6,15 +6,18 @@ package image
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
 	"github.com/talos-systems/go-retry/retry"
 
-	containerdrunner "github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
@@ -35,7 +38,8 @@ const (
 // Pull is a convenience function that wraps the containerd image pull func with
 // retry functionality.
 func Pull(ctx context.Context, reg config.Registries, client *containerd.Client, ref string) (img containerd.Image, err error) {
-	resolver := NewResolver(reg)
+	recorder := &endpointRecorder{}
+	resolver := newResolver(reg, recorder)
 
 	err = retry.Exponential(PullTimeout, retry.WithUnits(PullRetryInterval), retry.WithErrorLogging(true)).Retry(func() error {
 		if img, err = client.Pull(ctx, ref, containerd.WithPullUnpack, containerd.WithResolver(resolver)); err != nil {
@@ -55,25 +59,53 @@ func Pull(ctx context.Context, reg config.Registries, client *containerd.Client,
 		return nil, err
 	}
 
+	if served := recorder.served(); len(served) > 0 {
+		log.Printf("pulled %q via %s", ref, strings.Join(served, ", "))
+	}
+
 	return img, nil
 }
 
-// Import is a convenience function that wraps containerd image import with retries.
-func Import(ctx context.Context, imagePath, indexName string) error {
-	importer := containerdrunner.NewImporter(constants.SystemContainerdNamespace, containerdrunner.WithContainerdAddress(constants.SystemContainerdAddress))
+// Import is a convenience function that wraps containerd image import into the system namespace with retries.
+func Import(ctx context.Context, client *containerd.Client, imagePath, indexName string) error {
+	ctx = namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
 	return retry.Exponential(ImportTimeout, retry.WithUnits(ImportRetryInterval), retry.WithJitter(ImportRetryJitter), retry.WithErrorLogging(true)).Retry(func() error {
-		err := retry.ExpectedError(importer.Import(ctx, &containerdrunner.ImportRequest{
-			Path: imagePath,
-			Options: []containerd.ImportOpt{
-				containerd.WithIndexName(indexName),
-			},
-		}))
-
-		if err != nil && os.IsNotExist(err) {
+		err := importArchive(ctx, client, imagePath, containerd.WithIndexName(indexName))
+
+		if err != nil && errors.Is(err, os.ErrNotExist) {
 			return retry.UnexpectedError(err)
 		}
 
 		return retry.ExpectedError(err)
 	})
 }
+
+// importArchive imports and unpacks the images stored in the archive.
+func importArchive(ctx context.Context, client *containerd.Client, path string, opts ...containerd.ImportOpt) error {
+	tarball, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", path, err)
+	}
+
+	defer tarball.Close() //nolint: errcheck
+
+	imgs, err := client.Import(ctx, tarball, opts...)
+	if err != nil {
+		return fmt.Errorf("error importing %s: %w", path, err)
+	}
+
+	if err = tarball.Close(); err != nil {
+		return fmt.Errorf("error closing %s: %w", path, err)
+	}
+
+	for _, img := range imgs {
+		log.Printf("unpacking %s (%s)\n", img.Name, img.Target.Digest)
+
+		if err = containerd.NewImage(client, img).Unpack(ctx, containerd.DefaultSnapshotter); err != nil {
+			return fmt.Errorf("error unpacking %s: %w", img.Name, err)
+		}
+	}
+
+	return nil
+}
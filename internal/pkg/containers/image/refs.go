@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// maxManifestSize limits the size of the manifest or index fetched to build the list of refs.
+const maxManifestSize = 4 * 1024 * 1024
+
+// ContentRefs resolves the image and returns the content refs of the blobs downloaded by the pull of the image.
+//
+// Refs match the content.Status.Ref of the active downloads, so that the progress of the pull
+// can be told apart from the other downloads running at the same time.
+func ContentRefs(ctx context.Context, resolver remotes.Resolver, ref string, platform platforms.Matcher) (map[string]struct{}, error) {
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := map[string]struct{}{}
+	queue := []ocispec.Descriptor{desc}
+
+	for len(queue) > 0 {
+		desc, queue = queue[0], queue[1:]
+
+		refs[remotes.MakeRefKey(ctx, desc)] = struct{}{}
+
+		switch desc.MediaType {
+		case images.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+			var index ocispec.Index
+
+			if err = fetchJSON(ctx, fetcher, desc, &index); err != nil {
+				return nil, err
+			}
+
+			for _, manifest := range index.Manifests {
+				if manifest.Platform == nil || platform.Match(*manifest.Platform) {
+					queue = append(queue, manifest)
+				}
+			}
+		case images.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest:
+			var manifest ocispec.Manifest
+
+			if err = fetchJSON(ctx, fetcher, desc, &manifest); err != nil {
+				return nil, err
+			}
+
+			queue = append(queue, manifest.Config)
+			queue = append(queue, manifest.Layers...)
+		}
+	}
+
+	return refs, nil
+}
+
+func fetchJSON(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor, v interface{}) error {
+	r, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer r.Close()
+
+	return json.NewDecoder(io.LimitReader(r, maxManifestSize)).Decode(v)
+}
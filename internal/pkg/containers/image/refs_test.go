@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+)
+
+// mockResolver serves the blobs from memory.
+type mockResolver struct {
+	root  ocispec.Descriptor
+	blobs map[digest.Digest][]byte
+}
+
+func (r *mockResolver) add(mediaType string, v interface{}) ocispec.Descriptor {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	desc := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+
+	r.blobs[desc.Digest] = data
+
+	return desc
+}
+
+func (r *mockResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	return ref, r.root, nil
+}
+
+func (r *mockResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	return r, nil
+}
+
+func (r *mockResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (r *mockResolver) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	data, ok := r.blobs[desc.Digest]
+	if !ok {
+		return nil, fmt.Errorf("blob %s not found", desc.Digest)
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+type RefsSuite struct {
+	suite.Suite
+
+	resolver *mockResolver
+
+	config, layer ocispec.Descriptor
+	manifest      ocispec.Descriptor
+	otherConfig   ocispec.Descriptor
+	otherLayer    ocispec.Descriptor
+}
+
+func (suite *RefsSuite) SetupTest() {
+	suite.resolver = &mockResolver{
+		blobs: map[digest.Digest][]byte{},
+	}
+
+	blob := func(mediaType, content string) ocispec.Descriptor {
+		return ocispec.Descriptor{
+			MediaType: mediaType,
+			Digest:    digest.FromString(content),
+			Size:      int64(len(content)),
+		}
+	}
+
+	suite.config = blob(images.MediaTypeDockerSchema2Config, "config")
+	suite.layer = blob(images.MediaTypeDockerSchema2LayerGzip, "layer")
+	suite.otherConfig = blob(images.MediaTypeDockerSchema2Config, "other config")
+	suite.otherLayer = blob(images.MediaTypeDockerSchema2LayerGzip, "other layer")
+
+	suite.manifest = suite.resolver.add(images.MediaTypeDockerSchema2Manifest, ocispec.Manifest{
+		Config: suite.config,
+		Layers: []ocispec.Descriptor{suite.layer},
+	})
+}
+
+func (suite *RefsSuite) refKeys(descs ...ocispec.Descriptor) map[string]struct{} {
+	refs := map[string]struct{}{}
+
+	for _, desc := range descs {
+		refs[remotes.MakeRefKey(context.Background(), desc)] = struct{}{}
+	}
+
+	return refs
+}
+
+func (suite *RefsSuite) TestManifest() {
+	suite.resolver.root = suite.manifest
+
+	refs, err := image.ContentRefs(context.Background(), suite.resolver, "docker.io/library/test:latest", platforms.Default())
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(suite.refKeys(suite.manifest, suite.config, suite.layer), refs)
+}
+
+func (suite *RefsSuite) TestIndex() {
+	otherPlatform := ocispec.Platform{OS: "windows", Architecture: "amd64"}
+
+	otherManifest := suite.resolver.add(images.MediaTypeDockerSchema2Manifest, ocispec.Manifest{
+		Config: suite.otherConfig,
+		Layers: []ocispec.Descriptor{suite.otherLayer},
+	})
+	otherManifest.Platform = &otherPlatform
+
+	manifest := suite.manifest
+	defaultPlatform := platforms.DefaultSpec()
+	manifest.Platform = &defaultPlatform
+
+	suite.resolver.root = suite.resolver.add(ocispec.MediaTypeImageIndex, ocispec.Index{
+		Manifests: []ocispec.Descriptor{manifest, otherManifest},
+	})
+
+	refs, err := image.ContentRefs(context.Background(), suite.resolver, "docker.io/library/test:latest", platforms.Default())
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(suite.refKeys(suite.resolver.root, suite.manifest, suite.config, suite.layer), refs)
+}
+
+func (suite *RefsSuite) TestMissingManifest() {
+	suite.resolver.root = ocispec.Descriptor{
+		MediaType: images.MediaTypeDockerSchema2Manifest,
+		Digest:    digest.FromString("missing"),
+	}
+
+	_, err := image.ContentRefs(context.Background(), suite.resolver, "docker.io/library/test:latest", platforms.Default())
+	suite.Assert().Error(err)
+}
+
+func TestRefsSuite(t *testing.T) {
+	suite.Run(t, new(RefsSuite))
+}
@@ -22,15 +22,24 @@ import (
 
 // NewResolver builds registry resolver based on Talos configuration.
 func NewResolver(reg config.Registries) remotes.Resolver {
+	return newResolver(reg, nil)
+}
+
+func newResolver(reg config.Registries, recorder *endpointRecorder) remotes.Resolver {
 	return docker.NewResolver(docker.ResolverOptions{
-		Hosts: RegistryHosts(reg),
+		Hosts: registryHosts(reg, DefaultEndpointHealth, recorder),
 	})
 }
 
 // RegistryHosts returns host configuration per registry.
 //
-//nolint: gocyclo
+// Endpoints with the open circuit are skipped, unless all the endpoints are unavailable.
 func RegistryHosts(reg config.Registries) docker.RegistryHosts {
+	return registryHosts(reg, DefaultEndpointHealth, nil)
+}
+
+//nolint: gocyclo
+func registryHosts(reg config.Registries, health *EndpointHealth, recorder *endpointRecorder) docker.RegistryHosts {
 	return func(host string) ([]docker.RegistryHost, error) {
 		var registries []docker.RegistryHost
 
@@ -39,13 +48,19 @@ func RegistryHosts(reg config.Registries) docker.RegistryHosts {
 			return nil, err
 		}
 
+		mirrorConfig := hostMirrorConfig(reg, host)
+
 		for _, endpoint := range endpoints {
 			u, err := url.Parse(endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("error parsing endpoint %q for host %q: %w", endpoint, host, err)
 			}
 
-			transport := newTransport()
+			transport := &EndpointTransport{
+				Transport: newTransport(),
+				health:    health,
+				recorder:  recorder,
+			}
 			client := &http.Client{Transport: transport}
 
 			registryConfig := reg.Config()[u.Host]
@@ -61,9 +76,9 @@ func RegistryHosts(reg config.Registries) docker.RegistryHosts {
 				}
 			}
 
-			if u.Path == "" {
-				u.Path = "/v2"
-			}
+			u.Path = EndpointPath(u.Path, mirrorConfig != nil && mirrorConfig.AppendV2Path() && isMirrorEndpoint(mirrorConfig, endpoint))
+
+			transport.Endpoint = u.Scheme + "://" + u.Host + u.Path
 
 			uu := u
 
@@ -85,35 +100,103 @@ func RegistryHosts(reg config.Registries) docker.RegistryHosts {
 			})
 		}
 
-		return registries, nil
+		var available []docker.RegistryHost
+
+		for _, registry := range registries {
+			if health.Available(registry.Client.Transport.(*EndpointTransport).Endpoint) {
+				available = append(available, registry)
+			}
+		}
+
+		if len(available) == 0 {
+			// all the endpoints are down, try them all anyways
+			return registries, nil
+		}
+
+		return available, nil
 	}
 }
 
 // RegistryEndpoints returns registry endpoints per host using reg.
+//
+// Mirror endpoints are followed by the registry itself, unless the mirror
+// is configured to skip the fallback.
+//
+// This is a change from the previous releases, where the registry itself was never
+// used if the mirror endpoints are configured: `skipFallback` restores that behavior.
 func RegistryEndpoints(reg config.Registries, host string) ([]string, error) {
 	var endpoints []string
 
-	if hostConfig, ok := reg.Mirrors()[host]; ok {
-		endpoints = hostConfig.Endpoints()
+	mirrorConfig := hostMirrorConfig(reg, host)
+
+	if mirrorConfig != nil {
+		endpoints = append(endpoints, mirrorConfig.Endpoints()...)
+
+		if len(endpoints) > 0 && mirrorConfig.SkipFallback() {
+			return endpoints, nil
+		}
+	}
+
+	defaultHost, err := docker.DefaultHost(host)
+	if err != nil {
+		return nil, fmt.Errorf("error getting default host for %q: %w", host, err)
 	}
 
-	if endpoints == nil {
-		if catchAllConfig, ok := reg.Mirrors()["*"]; ok {
-			endpoints = catchAllConfig.Endpoints()
+	defaultEndpoint := "https://" + defaultHost
+
+	for _, endpoint := range endpoints {
+		if strings.TrimSuffix(endpoint, "/") == defaultEndpoint {
+			// registry is already listed as one of the mirrors
+			return endpoints, nil
 		}
 	}
 
-	if len(endpoints) == 0 {
-		// still no endpoints, use default
-		defaultHost, err := docker.DefaultHost(host)
-		if err != nil {
-			return nil, fmt.Errorf("error getting default host for %q: %w", host, err)
+	return append(endpoints, defaultEndpoint), nil
+}
+
+// EndpointPath returns registry API path for the endpoint path.
+//
+// Path is used as is (defaulting to `/v2`), unless appendV2 is set: then `/v2` is appended
+// to the path if it's not there yet.
+func EndpointPath(path string, appendV2 bool) string {
+	if !appendV2 {
+		if path == "" {
+			path = "/v2"
 		}
 
-		endpoints = append(endpoints, "https://"+defaultHost)
+		return path
+	}
+
+	path = strings.TrimSuffix(path, "/")
+
+	if !strings.HasSuffix(path, "/v2") {
+		path += "/v2"
+	}
+
+	return path
+}
+
+// hostMirrorConfig returns mirror config for the host falling back to the catch-all config.
+func hostMirrorConfig(reg config.Registries, host string) config.RegistryMirrorConfig {
+	if mirrorConfig, ok := reg.Mirrors()[host]; ok && mirrorConfig.Endpoints() != nil {
+		return mirrorConfig
+	}
+
+	if catchAllConfig, ok := reg.Mirrors()["*"]; ok {
+		return catchAllConfig
+	}
+
+	return nil
+}
+
+func isMirrorEndpoint(mirrorConfig config.RegistryMirrorConfig, endpoint string) bool {
+	for _, e := range mirrorConfig.Endpoints() {
+		if e == endpoint {
+			return true
+		}
 	}
 
-	return endpoints, nil
+	return false
 }
 
 // PrepareAuth returns authentication info in the format expected by containerd.
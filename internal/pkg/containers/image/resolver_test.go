@@ -71,7 +71,7 @@ func (suite *ResolverSuite) TestRegistryEndpoints() {
 
 	endpoints, err = image.RegistryEndpoints(cfg, "docker.io")
 	suite.Assert().NoError(err)
-	suite.Assert().Equal([]string{"http://127.0.0.1:5000", "https://some.host"}, endpoints)
+	suite.Assert().Equal([]string{"http://127.0.0.1:5000", "https://some.host", "https://registry-1.docker.io"}, endpoints)
 
 	endpoints, err = image.RegistryEndpoints(cfg, "quay.io")
 	suite.Assert().NoError(err)
@@ -91,11 +91,40 @@ func (suite *ResolverSuite) TestRegistryEndpoints() {
 
 	endpoints, err = image.RegistryEndpoints(cfg, "docker.io")
 	suite.Assert().NoError(err)
-	suite.Assert().Equal([]string{"http://127.0.0.1:5000", "https://some.host"}, endpoints)
+	suite.Assert().Equal([]string{"http://127.0.0.1:5000", "https://some.host", "https://registry-1.docker.io"}, endpoints)
 
 	endpoints, err = image.RegistryEndpoints(cfg, "quay.io")
 	suite.Assert().NoError(err)
-	suite.Assert().Equal([]string{"http://127.0.0.1:5001"}, endpoints)
+	suite.Assert().Equal([]string{"http://127.0.0.1:5001", "https://quay.io"}, endpoints)
+
+	// skip fallback and explicit upstream
+	cfg = &mockConfig{
+		mirrors: map[string]*v1alpha1.RegistryMirrorConfig{
+			"docker.io": {
+				MirrorEndpoints:    []string{"http://127.0.0.1:5000"},
+				MirrorSkipFallback: true,
+			},
+			"quay.io": {
+				MirrorEndpoints: []string{"https://quay.io/", "http://127.0.0.1:5001"},
+			},
+		},
+	}
+
+	endpoints, err = image.RegistryEndpoints(cfg, "docker.io")
+	suite.Assert().NoError(err)
+	suite.Assert().Equal([]string{"http://127.0.0.1:5000"}, endpoints)
+
+	endpoints, err = image.RegistryEndpoints(cfg, "quay.io")
+	suite.Assert().NoError(err)
+	suite.Assert().Equal([]string{"https://quay.io/", "http://127.0.0.1:5001"}, endpoints)
+}
+
+func (suite *ResolverSuite) TestEndpointPath() {
+	suite.Assert().Equal("/v2", image.EndpointPath("", false))
+	suite.Assert().Equal("/docker.io", image.EndpointPath("/docker.io", false))
+	suite.Assert().Equal("/v2", image.EndpointPath("", true))
+	suite.Assert().Equal("/v2", image.EndpointPath("/v2/", true))
+	suite.Assert().Equal("/docker.io/v2", image.EndpointPath("/docker.io", true))
 }
 
 func (suite *ResolverSuite) TestPrepareAuth() {
@@ -145,7 +174,7 @@ func (suite *ResolverSuite) TestRegistryHosts() {
 	suite.Assert().Equal("https", registryHosts[0].Scheme)
 	suite.Assert().Equal("registry-1.docker.io", registryHosts[0].Host)
 	suite.Assert().Equal("/v2", registryHosts[0].Path)
-	suite.Assert().Nil(registryHosts[0].Client.Transport.(*http.Transport).TLSClientConfig)
+	suite.Assert().Nil(registryHosts[0].Client.Transport.(*image.EndpointTransport).TLSClientConfig)
 
 	cfg := &mockConfig{
 		mirrors: map[string]*v1alpha1.RegistryMirrorConfig{
@@ -157,15 +186,33 @@ func (suite *ResolverSuite) TestRegistryHosts() {
 
 	registryHosts, err = image.RegistryHosts(cfg)("docker.io")
 	suite.Require().NoError(err)
-	suite.Assert().Len(registryHosts, 2)
+	suite.Assert().Len(registryHosts, 3)
 	suite.Assert().Equal("http", registryHosts[0].Scheme)
 	suite.Assert().Equal("127.0.0.1:5000", registryHosts[0].Host)
 	suite.Assert().Equal("/docker.io", registryHosts[0].Path)
-	suite.Assert().Nil(registryHosts[0].Client.Transport.(*http.Transport).TLSClientConfig)
+	suite.Assert().Nil(registryHosts[0].Client.Transport.(*image.EndpointTransport).TLSClientConfig)
 	suite.Assert().Equal("https", registryHosts[1].Scheme)
 	suite.Assert().Equal("some.host", registryHosts[1].Host)
 	suite.Assert().Equal("/v2", registryHosts[1].Path)
-	suite.Assert().Nil(registryHosts[1].Client.Transport.(*http.Transport).TLSClientConfig)
+	suite.Assert().Nil(registryHosts[1].Client.Transport.(*image.EndpointTransport).TLSClientConfig)
+	suite.Assert().Equal("https", registryHosts[2].Scheme)
+	suite.Assert().Equal("registry-1.docker.io", registryHosts[2].Host)
+	suite.Assert().Equal("/v2", registryHosts[2].Path)
+
+	cfg = &mockConfig{
+		mirrors: map[string]*v1alpha1.RegistryMirrorConfig{
+			"docker.io": {
+				MirrorEndpoints:    []string{"http://127.0.0.1:5000/docker.io"},
+				MirrorAppendV2Path: true,
+			},
+		},
+	}
+
+	registryHosts, err = image.RegistryHosts(cfg)("docker.io")
+	suite.Require().NoError(err)
+	suite.Assert().Len(registryHosts, 2)
+	suite.Assert().Equal("/docker.io/v2", registryHosts[0].Path)
+	suite.Assert().Equal("/v2", registryHosts[1].Path)
 
 	cfg = &mockConfig{
 		mirrors: map[string]*v1alpha1.RegistryMirrorConfig{
@@ -189,12 +236,12 @@ func (suite *ResolverSuite) TestRegistryHosts() {
 
 	registryHosts, err = image.RegistryHosts(cfg)("docker.io")
 	suite.Require().NoError(err)
-	suite.Assert().Len(registryHosts, 1)
+	suite.Assert().Len(registryHosts, 2)
 	suite.Assert().Equal("https", registryHosts[0].Scheme)
 	suite.Assert().Equal("some.host:123", registryHosts[0].Host)
 	suite.Assert().Equal("/v2", registryHosts[0].Path)
 
-	tlsClientConfig := registryHosts[0].Client.Transport.(*http.Transport).TLSClientConfig
+	tlsClientConfig := registryHosts[0].Client.Transport.(*image.EndpointTransport).TLSClientConfig
 	suite.Require().NotNil(tlsClientConfig)
 	suite.Require().NotNil(tlsClientConfig.RootCAs)
 	suite.Require().Empty(tlsClientConfig.Certificates)
@@ -53,3 +53,15 @@ func (c *Client) ImageStatus(ctx context.Context, image *runtimeapi.ImageSpec) (
 
 	return resp.Image, nil
 }
+
+// RemoveImage removes the image.
+func (c *Client) RemoveImage(ctx context.Context, image *runtimeapi.ImageSpec) error {
+	_, err := c.imagesClient.RemoveImage(ctx, &runtimeapi.RemoveImageRequest{
+		Image: image,
+	})
+	if err != nil {
+		return fmt.Errorf("error removing image %s: %w", image, err)
+	}
+
+	return nil
+}
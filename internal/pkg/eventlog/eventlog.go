@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package eventlog implements persistent size-bounded log of machine events.
+//
+// Log is stored as two files: current and rotated, each of them is limited to
+// half of the maximum log size. Records are length-prefixed serialized `machine.Event`
+// messages tagged with the boot ID.
+package eventlog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const (
+	logName    = "events.log"
+	rotatedExt = ".1"
+
+	// maxRecordSize protects from reading garbage as record length.
+	maxRecordSize = 1024 * 1024
+)
+
+// BootIDPath is the path to the kernel-generated boot ID.
+//
+// Exposed here for unit-tests to override.
+var BootIDPath = "/proc/sys/kernel/random/boot_id"
+
+// CurrentBootID returns the ID of the current boot.
+func CurrentBootID() (string, error) {
+	contents, err := ioutil.ReadFile(BootIDPath)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// Dir returns the event log directory for the partition label.
+func Dir(partition string) (string, error) {
+	switch partition {
+	case constants.StatePartitionLabel:
+		return filepath.Join(constants.StateMountPoint, constants.EventLogDir), nil
+	case constants.EphemeralPartitionLabel:
+		return filepath.Join(constants.EphemeralMountPoint, "log", constants.EventLogDir), nil
+	default:
+		return "", fmt.Errorf("unsupported event log partition %q", partition)
+	}
+}
+
+// Log is a persistent log of machine events.
+//
+// Log file is opened on every write, so that the log doesn't prevent the partition
+// from being unmounted.
+type Log struct {
+	mu sync.Mutex
+
+	dir     string
+	maxSize int64
+}
+
+// New initializes Log in the specified directory.
+func New(dir string, maxSize int64) *Log {
+	return &Log{
+		dir:     dir,
+		maxSize: maxSize,
+	}
+}
+
+func (l *Log) path() string {
+	return filepath.Join(l.dir, logName)
+}
+
+// Append writes the event to the log, rotating the log if it grows over the size limit.
+func (l *Log) Append(event *machine.Event) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err = os.MkdirAll(l.dir, 0o700); err != nil {
+		return err
+	}
+
+	if err = l.rotateIfNeeded(int64(len(record))); err != nil {
+		return fmt.Errorf("error rotating event log: %w", err)
+	}
+
+	f, err := os.OpenFile(l.path(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(record); err != nil {
+		f.Close() //nolint: errcheck
+
+		return err
+	}
+
+	return f.Close()
+}
+
+func (l *Log) rotateIfNeeded(recordSize int64) error {
+	st, err := os.Stat(l.path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if st.Size()+recordSize <= l.maxSize/2 {
+		return nil
+	}
+
+	return os.Rename(l.path(), l.path()+rotatedExt)
+}
+
+// Read calls f for each event of the specified boot in the order of appending.
+//
+// If bootID is empty, events of all the boots are returned.
+func (l *Log) Read(bootID string, f func(*machine.Event) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, path := range []string{l.path() + rotatedExt, l.path()} {
+		if err := readFile(path, bootID, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Boots returns the IDs of the boots in the log, from the oldest to the latest one.
+func (l *Log) Boots() ([]string, error) {
+	var boots []string
+
+	err := l.Read("", func(event *machine.Event) error {
+		if len(boots) == 0 || boots[len(boots)-1] != event.BootId {
+			boots = append(boots, event.BootId)
+		}
+
+		return nil
+	})
+
+	return boots, err
+}
+
+func readFile(path, bootID string, f func(*machine.Event) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	defer file.Close() //nolint: errcheck
+
+	r := bufio.NewReader(file)
+
+	var header [4]byte
+
+	for {
+		if _, err = io.ReadFull(r, header[:]); err != nil {
+			// partially written record (e.g. on crash) terminates the log
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		size := binary.BigEndian.Uint32(header[:])
+		if size > maxRecordSize {
+			return fmt.Errorf("corrupted event log %q: record size %d", path, size)
+		}
+
+		data := make([]byte, size)
+
+		if _, err = io.ReadFull(r, data); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		var event machine.Event
+
+		if err = proto.Unmarshal(data, &event); err != nil {
+			return fmt.Errorf("corrupted event log %q: %w", path, err)
+		}
+
+		if bootID != "" && event.BootId != bootID {
+			continue
+		}
+
+		if err = f(&event); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eventlog_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/eventlog"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func readIDs(t *testing.T, l *eventlog.Log, bootID string) []string {
+	var ids []string
+
+	require.NoError(t, l.Read(bootID, func(event *machine.Event) error {
+		ids = append(ids, event.Id)
+
+		return nil
+	}))
+
+	return ids
+}
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eventlog")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	l := eventlog.New(filepath.Join(dir, "events"), 1024*1024)
+
+	boots, err := l.Boots()
+	require.NoError(t, err)
+	assert.Empty(t, boots)
+
+	for i, bootID := range []string{"boot1", "boot1", "boot2", "boot3", "boot3"} {
+		require.NoError(t, l.Append(&machine.Event{Id: fmt.Sprint(i), BootId: bootID}))
+	}
+
+	boots, err = l.Boots()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"boot1", "boot2", "boot3"}, boots)
+
+	assert.Equal(t, []string{"0", "1"}, readIDs(t, l, "boot1"))
+	assert.Equal(t, []string{"3", "4"}, readIDs(t, l, "boot3"))
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, readIDs(t, l, ""))
+
+	// partially written record is ignored
+	f, err := os.OpenFile(filepath.Join(dir, "events", "events.log"), os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+
+	_, err = f.Write([]byte{0, 0, 1})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	assert.Equal(t, []string{"3", "4"}, readIDs(t, l, "boot3"))
+}
+
+func TestLogRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eventlog")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	// each record is 4 + 10 bytes long, so each file fits 4 records
+	l := eventlog.New(dir, 128)
+
+	for i := 0; i < 20; i++ {
+		require.NoError(t, l.Append(&machine.Event{Id: fmt.Sprintf("%02d", i), BootId: "boot"}))
+	}
+
+	assert.Equal(t, []string{"12", "13", "14", "15", "16", "17", "18", "19"}, readIDs(t, l, "boot"))
+
+	for _, name := range []string{"events.log", "events.log.1"} {
+		st, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+
+		assert.LessOrEqual(t, st.Size(), int64(64))
+	}
+}
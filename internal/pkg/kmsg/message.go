@@ -39,14 +39,27 @@ const (
 	Local7
 )
 
+var facilityNames = [...]string{
+	"kern", "user", "mail", "daemon",
+	"auth", "syslog", "lpr", "news", "uucp",
+	"cron", "authpriv",
+	"local0", "local1", "local2", "local3",
+	"local4", "local5", "local6", "local7",
+}
+
 func (f Facility) String() string {
-	return [...]string{
-		"kern", "user", "mail", "daemon",
-		"auth", "syslog", "lpr", "news", "uucp",
-		"cron", "authpriv",
-		"local0", "local1", "local2", "local3",
-		"local4", "local5", "local6", "local7",
-	}[f]
+	return facilityNames[f]
+}
+
+// ParseFacility parses facility name.
+func ParseFacility(name string) (Facility, error) {
+	for f, facilityName := range facilityNames {
+		if facilityName == name {
+			return Facility(f), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown facility %q", name)
 }
 
 // Priority is an attribute of kernel log message.
@@ -64,8 +77,32 @@ const (
 	Debug
 )
 
+var priorityNames = [...]string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
 func (p Priority) String() string {
-	return [...]string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}[p]
+	return priorityNames[p]
+}
+
+// ParsePriority parses priority name.
+//
+// Aliases "panic", "error" and "warn" are accepted as well.
+func ParsePriority(name string) (Priority, error) {
+	switch name {
+	case "panic":
+		return Emerg, nil
+	case "error":
+		return Err, nil
+	case "warn":
+		return Warning, nil
+	}
+
+	for p, priorityName := range priorityNames {
+		if priorityName == name {
+			return Priority(p), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown priority %q", name)
 }
 
 // Message is a parsed kernel log message.
@@ -58,3 +58,36 @@ func TestParseMessage(t *testing.T) {
 		assert.Equal(t, testCase.expected, message)
 	}
 }
+
+func TestParsePriority(t *testing.T) {
+	for name, expected := range map[string]kmsg.Priority{
+		"emerg":   kmsg.Emerg,
+		"panic":   kmsg.Emerg,
+		"err":     kmsg.Err,
+		"error":   kmsg.Err,
+		"warn":    kmsg.Warning,
+		"warning": kmsg.Warning,
+		"debug":   kmsg.Debug,
+	} {
+		priority, err := kmsg.ParsePriority(name)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expected, priority)
+	}
+
+	_, err := kmsg.ParsePriority("verbose")
+	assert.EqualError(t, err, "unknown priority \"verbose\"")
+}
+
+func TestParseFacility(t *testing.T) {
+	facility, err := kmsg.ParseFacility("daemon")
+	assert.NoError(t, err)
+	assert.Equal(t, kmsg.Daemon, facility)
+
+	facility, err = kmsg.ParseFacility("local7")
+	assert.NoError(t, err)
+	assert.Equal(t, kmsg.Local7, facility)
+
+	_, err = kmsg.ParseFacility("kernel")
+	assert.EqualError(t, err, "unknown facility \"kernel\"")
+}
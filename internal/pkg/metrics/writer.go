@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package metrics implements Prometheus text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the content type of Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Type of the metric family.
+type Type string
+
+// Metric family types.
+const (
+	Gauge   Type = "gauge"
+	Counter Type = "counter"
+)
+
+// Writer writes metrics in Prometheus text exposition format.
+//
+// Each metric family should be started with Family, followed by samples of this family.
+// Write errors are sticky and returned from Flush.
+type Writer struct {
+	w   *bufio.Writer
+	err error
+}
+
+// NewWriter initializes Writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w: bufio.NewWriter(w),
+	}
+}
+
+// Family writes metric family header.
+func (w *Writer) Family(name string, typ Type, help string) {
+	w.printf("# HELP %s %s\n", name, escapeHelp(help))
+	w.printf("# TYPE %s %s\n", name, typ)
+}
+
+// Sample writes a single sample of the metric.
+//
+// Labels are passed as name, value pairs.
+func (w *Writer) Sample(name string, value float64, labels ...string) {
+	if len(labels)%2 != 0 {
+		panic("labels should be passed as name, value pairs")
+	}
+
+	if len(labels) == 0 {
+		w.printf("%s %s\n", name, formatValue(value))
+
+		return
+	}
+
+	pairs := make([]string, 0, len(labels)/2)
+
+	for i := 0; i < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", labels[i], escapeLabelValue(labels[i+1])))
+	}
+
+	w.printf("%s{%s} %s\n", name, strings.Join(pairs, ","), formatValue(value))
+}
+
+// Flush flushes buffered output and returns first error encountered.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	return w.w.Flush()
+}
+
+func (w *Writer) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+
+	_, w.err = fmt.Fprintf(w.w, format, args...)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, +1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	w := metrics.NewWriter(&buf)
+
+	w.Family("talos_load_average", metrics.Gauge, "System load average.")
+	w.Sample("talos_load_average", 0.5, "period", "1m")
+	w.Sample("talos_load_average", 1e-7, "period", "5m")
+
+	w.Family("talos_service_state", metrics.Gauge, "Current state of the service,\nwith \\ escaped.")
+	w.Sample("talos_service_state", 1, "service", "apid", "state", `say "hi"`+"\n")
+
+	w.Family("talos_boot_time_seconds", metrics.Gauge, "Boot time.")
+	w.Sample("talos_boot_time_seconds", 1612345678)
+	w.Sample("talos_boot_time_seconds", math.Inf(+1))
+	w.Sample("talos_boot_time_seconds", math.NaN())
+
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, `# HELP talos_load_average System load average.
+# TYPE talos_load_average gauge
+talos_load_average{period="1m"} 0.5
+talos_load_average{period="5m"} 1e-07
+# HELP talos_service_state Current state of the service,\nwith \\ escaped.
+# TYPE talos_service_state gauge
+talos_service_state{service="apid",state="say \"hi\"\n"} 1
+# HELP talos_boot_time_seconds Boot time.
+# TYPE talos_boot_time_seconds gauge
+talos_boot_time_seconds 1.612345678e+09
+talos_boot_time_seconds +Inf
+talos_boot_time_seconds NaN
+`, buf.String())
+}
@@ -74,6 +74,10 @@ func SystemMountPointForLabel(label string, opts ...Option) (mountpoint *Point,
 		target = constants.EFIMountPoint
 	case constants.StatePartitionLabel:
 		target = constants.StateMountPoint
+	case constants.ImageCachePartitionLabel:
+		target = constants.ImageCacheMountPoint
+
+		opts = append(opts, WithReadOnly(true))
 	default:
 		return nil, fmt.Errorf("unknown label: %q", label)
 	}
@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package pcap implements packet capture on the network interfaces.
+package pcap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// pollInterval is the interval to check for the context cancellation while waiting for packets.
+const pollInterval = 100 * time.Millisecond
+
+// ARP hardware types from linux/if_arp.h.
+const (
+	arphrdEther    = 1
+	arphrdLoopback = 772
+	arphrdNone     = 65534
+)
+
+// Options configures the packet capture.
+type Options struct {
+	Interface   string
+	Promiscuous bool
+	SnapLen     uint32
+	// Filter is a classic BPF program, empty filter accepts all the packets.
+	Filter []bpf.RawInstruction
+}
+
+// Capture captures the packets on the interface and writes them to w in pcap-ng format.
+//
+// Capture returns when the context is canceled.
+//
+//nolint: gocyclo
+func Capture(ctx context.Context, opts Options, w io.Writer) error {
+	if opts.SnapLen > constants.MaxPacketCaptureSnapLen {
+		return fmt.Errorf("snap length %d is over the limit %d", opts.SnapLen, constants.MaxPacketCaptureSnapLen)
+	}
+
+	iface, err := net.InterfaceByName(opts.Interface)
+	if err != nil {
+		return fmt.Errorf("error looking up interface: %w", err)
+	}
+
+	snapLen := opts.SnapLen
+	if snapLen == 0 {
+		snapLen = constants.DefaultPacketCaptureSnapLen
+	}
+
+	linkType, err := InterfaceLinkType(iface.Name)
+	if err != nil {
+		return err
+	}
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		return fmt.Errorf("error opening packet socket: %w", err)
+	}
+
+	//nolint: errcheck
+	defer unix.Close(fd)
+
+	if err = setup(fd, iface, opts, snapLen); err != nil {
+		return err
+	}
+
+	writer, err := NewWriter(w, iface.Name, linkType, snapLen)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, snapLen)
+	oob := make([]byte, unix.CmsgSpace(int(unsafe.Sizeof(unix.Timespec{}))))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		n, oobn, _, _, recvErr := unix.Recvmsg(fd, buf, oob, unix.MSG_TRUNC)
+		if recvErr != nil {
+			if errors.Is(recvErr, unix.EAGAIN) || errors.Is(recvErr, unix.EINTR) {
+				continue
+			}
+
+			return fmt.Errorf("error receiving packet: %w", recvErr)
+		}
+
+		captured := n
+		if captured > len(buf) {
+			captured = len(buf)
+		}
+
+		if err = writer.WritePacket(packetTimestamp(oob[:oobn]), n, buf[:captured]); err != nil {
+			return err
+		}
+	}
+}
+
+// setup binds the socket to the interface and attaches the filter.
+func setup(fd int, iface *net.Interface, opts Options, snapLen uint32) error {
+	// drop everything until the socket is bound to the interface, so that
+	// packets from other interfaces don't leak into the capture
+	if err := attachFilter(fd, []bpf.RawInstruction{{Op: unix.BPF_RET | unix.BPF_K, K: 0}}); err != nil {
+		return err
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{
+		Protocol: htons(unix.ETH_P_ALL),
+		Ifindex:  iface.Index,
+	}); err != nil {
+		return fmt.Errorf("error binding to interface: %w", err)
+	}
+
+	if err := drain(fd); err != nil {
+		return err
+	}
+
+	filter := opts.Filter
+	if len(filter) == 0 {
+		filter = []bpf.RawInstruction{{Op: unix.BPF_RET | unix.BPF_K, K: snapLen}}
+	}
+
+	if err := attachFilter(fd, filter); err != nil {
+		return err
+	}
+
+	if opts.Promiscuous {
+		if err := unix.SetsockoptPacketMreq(fd, unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &unix.PacketMreq{
+			Ifindex: int32(iface.Index),
+			Type:    unix.PACKET_MR_PROMISC,
+		}); err != nil {
+			return fmt.Errorf("error enabling promiscuous mode: %w", err)
+		}
+	}
+
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_TIMESTAMPNS, 1); err != nil {
+		return fmt.Errorf("error enabling timestamps: %w", err)
+	}
+
+	tv := unix.NsecToTimeval(pollInterval.Nanoseconds())
+
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		return fmt.Errorf("error setting receive timeout: %w", err)
+	}
+
+	return nil
+}
+
+func attachFilter(fd int, filter []bpf.RawInstruction) error {
+	prog := make([]unix.SockFilter, len(filter))
+
+	for i, ins := range filter {
+		prog[i] = unix.SockFilter{
+			Code: ins.Op,
+			Jt:   ins.Jt,
+			Jf:   ins.Jf,
+			K:    ins.K,
+		}
+	}
+
+	if err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &unix.SockFprog{
+		Len:    uint16(len(prog)),
+		Filter: &prog[0],
+	}); err != nil {
+		return fmt.Errorf("error attaching filter: %w", err)
+	}
+
+	return nil
+}
+
+// drain discards the packets received before the socket was bound.
+func drain(fd int) error {
+	buf := make([]byte, 1)
+
+	for {
+		_, _, err := unix.Recvfrom(fd, buf, unix.MSG_DONTWAIT|unix.MSG_TRUNC)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) {
+				return nil
+			}
+
+			return fmt.Errorf("error draining socket: %w", err)
+		}
+	}
+}
+
+func packetTimestamp(oob []byte) time.Time {
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err == nil {
+		for _, msg := range msgs {
+			if msg.Header.Level == unix.SOL_SOCKET && msg.Header.Type == unix.SCM_TIMESTAMPNS && len(msg.Data) >= int(unsafe.Sizeof(unix.Timespec{})) {
+				ts := *(*unix.Timespec)(unsafe.Pointer(&msg.Data[0]))
+
+				return time.Unix(ts.Unix())
+			}
+		}
+	}
+
+	return time.Now()
+}
+
+// InterfaceLinkType maps the interface hardware type to the pcap link type.
+func InterfaceLinkType(iface string) (uint16, error) {
+	contents, err := ioutil.ReadFile(filepath.Join("/sys/class/net", iface, "type"))
+	if err != nil {
+		return 0, fmt.Errorf("error reading interface type: %w", err)
+	}
+
+	hwType, err := strconv.Atoi(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return 0, fmt.Errorf("error parsing interface type: %w", err)
+	}
+
+	switch hwType {
+	case arphrdEther, arphrdLoopback:
+		return LinkTypeEthernet, nil
+	case arphrdNone:
+		return LinkTypeRaw, nil
+	default:
+		return 0, fmt.Errorf("unsupported interface type %d", hwType)
+	}
+}
+
+func htons(v uint16) uint16 {
+	return (v << 8) | (v >> 8)
+}
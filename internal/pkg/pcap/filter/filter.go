@@ -0,0 +1,434 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package filter compiles a subset of pcap-filter(7) expressions into classic BPF.
+//
+// Supported primitives (arp is available on Ethernet links only):
+//
+//   ip, ip6, arp, tcp, udp, icmp, icmp6
+//   [ip|ip6] [src|dst] host <address>
+//   [ip|ip6] [src|dst] net <cidr>
+//   [tcp|udp] [src|dst] port <number>
+//
+// Primitives can be combined with `and` (`&&`), `or` (`||`), `not` (`!`) and parentheses.
+//
+// Program is compiled for the link type of the captured interface: Ethernet frames or raw IP packets.
+package filter
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+
+	"golang.org/x/net/bpf"
+)
+
+// Link is the link layer of the captured packets.
+type Link int
+
+// Supported link layers.
+const (
+	// LinkEthernet is Ethernet frames.
+	LinkEthernet Link = iota
+	// LinkRaw is raw IP packets without the link layer header.
+	LinkRaw
+)
+
+// Offset of the ether type in the Ethernet frame.
+const offEtherType = 12
+
+// Offsets in the network layer header.
+const (
+	offIPVersion = 0
+	offIPProto   = 9
+	offIPFrag    = 6
+	offIPSrc     = 12
+	offIPDst     = 16
+	offIP6Next   = 6
+	offIP6Src    = 8
+	offIP6Dst    = 24
+	offIP6Ports  = 40
+)
+
+// Length of the Ethernet frame header.
+const ethernetHeaderLen = 14
+
+// Ether types and IP protocol numbers.
+const (
+	etherTypeIP   = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeIP6  = 0x86dd
+	ipProtoICMP   = 1
+	ipProtoTCP    = 6
+	ipProtoUDP    = 17
+	ipProtoICMP6  = 58
+	ipFragOffMask = 0x1fff
+	ipVersionMask = 0xf0
+	ipVersion4    = 0x40
+	ipVersion6    = 0x60
+)
+
+// Compile compiles the filter expression into a BPF program which accepts up to snapLen bytes of the matching packets.
+func Compile(expr string, link Link, snapLen uint32) ([]bpf.RawInstruction, error) {
+	root, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &compiler{
+		link: link,
+	}
+
+	switch link {
+	case LinkEthernet:
+		c.nh = ethernetHeaderLen
+	case LinkRaw:
+		c.nh = 0
+	default:
+		return nil, fmt.Errorf("unsupported link %d", link)
+	}
+
+	accept, reject := c.newLabel(), c.newLabel()
+
+	root.compile(c, accept, reject)
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	c.place(accept)
+	c.emit(bpf.RetConstant{Val: snapLen})
+	c.place(reject)
+	c.emit(bpf.RetConstant{Val: 0})
+
+	return c.assemble()
+}
+
+// label is a forward reference to the position in the program.
+type label int
+
+type item struct {
+	ins bpf.Instruction
+
+	// set for the conditional jumps, ins is bpf.JumpIf
+	conditional bool
+	jt, jf      label
+}
+
+type compiler struct {
+	items  []item
+	labels []int
+
+	link Link
+	// nh is the offset of the network layer header
+	nh uint32
+
+	// err is set if the expression can't be compiled for the link type
+	err error
+}
+
+func (c *compiler) newLabel() label {
+	c.labels = append(c.labels, -1)
+
+	return label(len(c.labels) - 1)
+}
+
+func (c *compiler) place(l label) {
+	c.labels[l] = len(c.items)
+}
+
+func (c *compiler) emit(ins bpf.Instruction) {
+	c.items = append(c.items, item{ins: ins})
+}
+
+func (c *compiler) jump(cond bpf.JumpTest, val uint32, t, f label) {
+	c.items = append(c.items, item{
+		ins:         bpf.JumpIf{Cond: cond, Val: val},
+		conditional: true,
+		jt:          t,
+		jf:          f,
+	})
+}
+
+func (c *compiler) assemble() ([]bpf.RawInstruction, error) {
+	program := make([]bpf.Instruction, len(c.items))
+
+	for i, it := range c.items {
+		if !it.conditional {
+			program[i] = it.ins
+
+			continue
+		}
+
+		jt, err := c.skip(i, it.jt)
+		if err != nil {
+			return nil, err
+		}
+
+		jf, err := c.skip(i, it.jf)
+		if err != nil {
+			return nil, err
+		}
+
+		jump := it.ins.(bpf.JumpIf)
+		jump.SkipTrue, jump.SkipFalse = jt, jf
+
+		program[i] = jump
+	}
+
+	return bpf.Assemble(program)
+}
+
+// skip computes the jump offset from the instruction at pos to the label.
+func (c *compiler) skip(pos int, l label) (uint8, error) {
+	offset := c.labels[l] - pos - 1
+
+	if offset < 0 || offset > 255 {
+		return 0, fmt.Errorf("filter expression is too complex")
+	}
+
+	return uint8(offset), nil
+}
+
+// test is a part of the expression which jumps to t if matched and to f otherwise.
+type test func(t, f label)
+
+// all matches if all the tests match.
+func (c *compiler) all(tests ...test) test {
+	return func(t, f label) {
+		for _, tt := range tests[:len(tests)-1] {
+			next := c.newLabel()
+			tt(next, f)
+			c.place(next)
+		}
+
+		tests[len(tests)-1](t, f)
+	}
+}
+
+// any matches if any of the tests match.
+func (c *compiler) any(tests ...test) test {
+	return func(t, f label) {
+		for _, tt := range tests[:len(tests)-1] {
+			next := c.newLabel()
+			tt(t, next)
+			c.place(next)
+		}
+
+		tests[len(tests)-1](t, f)
+	}
+}
+
+// cmp loads the value at the offset and compares it with val.
+func (c *compiler) cmp(off uint32, size int, val uint32) test {
+	return func(t, f label) {
+		c.emit(bpf.LoadAbsolute{Off: off, Size: size})
+		c.jump(bpf.JumpEqual, val, t, f)
+	}
+}
+
+// cmpMasked loads the word at the offset and compares it with val under the mask.
+func (c *compiler) cmpMasked(off, mask, val uint32) test {
+	return func(t, f label) {
+		c.emit(bpf.LoadAbsolute{Off: off, Size: 4})
+
+		if mask != 0xffffffff {
+			c.emit(bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: mask})
+		}
+
+		c.jump(bpf.JumpEqual, val&mask, t, f)
+	}
+}
+
+// etherType matches the network layer protocol.
+//
+// Raw IP packets don't have the link layer header, so the protocol is derived from the IP version.
+func (c *compiler) etherType(etherType uint32) test {
+	if c.link == LinkEthernet {
+		return c.cmp(offEtherType, 2, etherType)
+	}
+
+	return func(t, f label) {
+		var version uint32
+
+		switch etherType {
+		case etherTypeIP:
+			version = ipVersion4
+		case etherTypeIP6:
+			version = ipVersion6
+		default:
+			c.err = errors.New("only ip and ip6 protocols can be matched on raw IP links")
+
+			return
+		}
+
+		c.emit(bpf.LoadAbsolute{Off: c.nh + offIPVersion, Size: 1})
+		c.emit(bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: ipVersionMask})
+		c.jump(bpf.JumpEqual, version, t, f)
+	}
+}
+
+func (c *compiler) ipProto(proto uint32) test {
+	return c.all(c.etherType(etherTypeIP), c.cmp(c.nh+offIPProto, 1, proto))
+}
+
+func (c *compiler) ip6Next(proto uint32) test {
+	return c.all(c.etherType(etherTypeIP6), c.cmp(c.nh+offIP6Next, 1, proto))
+}
+
+// addrMatch matches the address at the offset under the mask.
+func (c *compiler) addrMatch(off uint32, addr, mask []byte) test {
+	var tests []test
+
+	for i := 0; i < len(addr); i += 4 {
+		m := binary.BigEndian.Uint32(mask[i:])
+		if m == 0 {
+			continue
+		}
+
+		tests = append(tests, c.cmpMasked(off+uint32(i), m, binary.BigEndian.Uint32(addr[i:])))
+	}
+
+	if len(tests) == 0 {
+		// 0.0.0.0/0 matches everything
+		return func(t, f label) {
+			c.emit(bpf.LoadConstant{Dst: bpf.RegA, Val: 0})
+			c.jump(bpf.JumpEqual, 0, t, f)
+		}
+	}
+
+	return c.all(tests...)
+}
+
+// ipMatch matches source and/or destination address of IPv4 or IPv6 packet.
+func (c *compiler) ipMatch(dir direction, ip net.IP, mask net.IPMask) test {
+	if ip4 := ip.To4(); ip4 != nil {
+		if len(mask) == net.IPv6len {
+			mask = mask[12:]
+		}
+
+		return c.all(c.etherType(etherTypeIP), c.direction(dir,
+			c.addrMatch(c.nh+offIPSrc, ip4, mask),
+			c.addrMatch(c.nh+offIPDst, ip4, mask),
+		))
+	}
+
+	return c.all(c.etherType(etherTypeIP6), c.direction(dir,
+		c.addrMatch(c.nh+offIP6Src, ip.To16(), mask),
+		c.addrMatch(c.nh+offIP6Dst, ip.To16(), mask),
+	))
+}
+
+func (c *compiler) direction(dir direction, src, dst test) test {
+	switch dir {
+	case dirSrc:
+		return src
+	case dirDst:
+		return dst
+	default:
+		return c.any(src, dst)
+	}
+}
+
+// transport matches TCP or UDP depending on the protocol qualifier.
+func (c *compiler) transport(proto protocol, match func(proto uint32) test) test {
+	switch proto {
+	case protoTCP:
+		return match(ipProtoTCP)
+	case protoUDP:
+		return match(ipProtoUDP)
+	default:
+		return c.any(match(ipProtoTCP), match(ipProtoUDP))
+	}
+}
+
+func (c *compiler) portMatch(proto protocol, dir direction, port uint16) test {
+	ip4Port := func(off uint32) test {
+		return func(t, f label) {
+			c.emit(bpf.LoadMemShift{Off: c.nh})
+			c.emit(bpf.LoadIndirect{Off: c.nh + off, Size: 2})
+			c.jump(bpf.JumpEqual, uint32(port), t, f)
+		}
+	}
+
+	// fragments other than the first one don't have the transport header
+	notFragment := func(t, f label) {
+		c.emit(bpf.LoadAbsolute{Off: c.nh + offIPFrag, Size: 2})
+		c.jump(bpf.JumpBitsSet, ipFragOffMask, f, t)
+	}
+
+	ip4 := c.all(
+		c.transport(proto, c.ipProto),
+		notFragment,
+		c.direction(dir, ip4Port(0), ip4Port(2)),
+	)
+
+	ip6 := c.all(
+		c.transport(proto, c.ip6Next),
+		c.direction(dir, c.cmp(c.nh+offIP6Ports, 2, uint32(port)), c.cmp(c.nh+offIP6Ports+2, 2, uint32(port))),
+	)
+
+	return c.any(ip4, ip6)
+}
+
+func (n andNode) compile(c *compiler, t, f label) {
+	c.all(
+		func(t, f label) { n.left.compile(c, t, f) },
+		func(t, f label) { n.right.compile(c, t, f) },
+	)(t, f)
+}
+
+func (n orNode) compile(c *compiler, t, f label) {
+	c.any(
+		func(t, f label) { n.left.compile(c, t, f) },
+		func(t, f label) { n.right.compile(c, t, f) },
+	)(t, f)
+}
+
+func (n notNode) compile(c *compiler, t, f label) {
+	n.expr.compile(c, f, t)
+}
+
+func (n protoNode) compile(c *compiler, t, f label) {
+	var tt test
+
+	switch n.proto {
+	case protoIP:
+		tt = c.etherType(etherTypeIP)
+	case protoIP6:
+		tt = c.etherType(etherTypeIP6)
+	case protoARP:
+		tt = c.etherType(etherTypeARP)
+	case protoTCP:
+		tt = c.any(c.ipProto(ipProtoTCP), c.ip6Next(ipProtoTCP))
+	case protoUDP:
+		tt = c.any(c.ipProto(ipProtoUDP), c.ip6Next(ipProtoUDP))
+	case protoICMP:
+		tt = c.ipProto(ipProtoICMP)
+	case protoICMP6:
+		tt = c.ip6Next(ipProtoICMP6)
+	case protoNone:
+		panic("protocol is not set")
+	}
+
+	tt(t, f)
+}
+
+func (n hostNode) compile(c *compiler, t, f label) {
+	bits := net.IPv6len * 8
+	if n.ip.To4() != nil {
+		bits = net.IPv4len * 8
+	}
+
+	c.ipMatch(n.dir, n.ip, net.CIDRMask(bits, bits))(t, f)
+}
+
+func (n netNode) compile(c *compiler, t, f label) {
+	c.ipMatch(n.dir, n.net.IP, n.net.Mask)(t, f)
+}
+
+func (n portNode) compile(c *compiler, t, f label) {
+	c.portMatch(n.proto, n.dir, n.port)(t, f)
+}
@@ -0,0 +1,299 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package filter_test
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+
+	"github.com/talos-systems/talos/internal/pkg/pcap/filter"
+)
+
+const snapLen = 96
+
+func ethernet(etherType uint16, payload []byte) []byte {
+	frame := make([]byte, 14)
+	binary.BigEndian.PutUint16(frame[12:], etherType)
+
+	return append(frame, payload...)
+}
+
+func ports(src, dst uint16) []byte {
+	b := make([]byte, 20)
+	binary.BigEndian.PutUint16(b[0:], src)
+	binary.BigEndian.PutUint16(b[2:], dst)
+
+	return b
+}
+
+func ip4(proto uint8, src, dst string, fragOffset uint16, payload []byte) []byte {
+	header := make([]byte, 20)
+	header[0] = 0x45
+	binary.BigEndian.PutUint16(header[6:], fragOffset)
+	header[9] = proto
+	copy(header[12:], net.ParseIP(src).To4())
+	copy(header[16:], net.ParseIP(dst).To4())
+
+	return ethernet(0x0800, append(header, payload...))
+}
+
+func ip6(next uint8, src, dst string, payload []byte) []byte {
+	header := make([]byte, 40)
+	header[0] = 0x60
+	header[6] = next
+	copy(header[8:], net.ParseIP(src).To16())
+	copy(header[24:], net.ParseIP(dst).To16())
+
+	return ethernet(0x86dd, append(header, payload...))
+}
+
+//nolint: gocyclo
+func TestCompile(t *testing.T) {
+	var (
+		tcp4     = ip4(6, "10.5.0.2", "10.5.0.3", 0, ports(40000, 443))
+		udp4     = ip4(17, "10.5.0.2", "8.8.8.8", 0, ports(5353, 53))
+		fragment = ip4(17, "10.5.0.2", "8.8.8.8", 100, ports(5353, 53))
+		icmp4    = ip4(1, "10.5.0.2", "10.5.0.3", 0, make([]byte, 8))
+		tcp6     = ip6(6, "fd00::2", "fd00::3", ports(40000, 443))
+		udp6     = ip6(17, "fd00::2", "2001:4860::8888", ports(5353, 53))
+		icmp6    = ip6(58, "fd00::2", "fd00::3", make([]byte, 8))
+		arp      = ethernet(0x0806, make([]byte, 28))
+	)
+
+	for _, test := range []struct {
+		expr      string
+		matches   [][]byte
+		unmatched [][]byte
+	}{
+		{
+			expr:      "tcp",
+			matches:   [][]byte{tcp4, tcp6},
+			unmatched: [][]byte{udp4, udp6, icmp4, arp},
+		},
+		{
+			expr:      "ip",
+			matches:   [][]byte{tcp4, udp4, icmp4},
+			unmatched: [][]byte{tcp6, arp},
+		},
+		{
+			expr:      "arp or icmp or icmp6",
+			matches:   [][]byte{arp, icmp4, icmp6},
+			unmatched: [][]byte{tcp4, udp6},
+		},
+		{
+			expr:      "port 53",
+			matches:   [][]byte{udp4, udp6},
+			unmatched: [][]byte{tcp4, tcp6, fragment, icmp4, arp},
+		},
+		{
+			expr:      "tcp dst port 443",
+			matches:   [][]byte{tcp4, tcp6},
+			unmatched: [][]byte{udp4},
+		},
+		{
+			expr:      "src port 443",
+			unmatched: [][]byte{tcp4, tcp6},
+		},
+		{
+			expr:      "udp port 443",
+			unmatched: [][]byte{tcp4, tcp6},
+		},
+		{
+			expr:      "host 10.5.0.3",
+			matches:   [][]byte{tcp4, icmp4},
+			unmatched: [][]byte{udp4, tcp6, arp},
+		},
+		{
+			expr:      "src host 10.5.0.3",
+			unmatched: [][]byte{tcp4, icmp4},
+		},
+		{
+			expr:      "dst host fd00::3",
+			matches:   [][]byte{tcp6, icmp6},
+			unmatched: [][]byte{udp6, tcp4},
+		},
+		{
+			expr:      "net 10.0.0.0/8 and not net 10.5.0.3/32",
+			matches:   [][]byte{udp4},
+			unmatched: [][]byte{tcp4, icmp4, tcp6},
+		},
+		{
+			expr:      "ip6 dst net 2001:4860::/32",
+			matches:   [][]byte{udp6},
+			unmatched: [][]byte{tcp6, udp4},
+		},
+		{
+			expr:      "net 0.0.0.0/0",
+			matches:   [][]byte{tcp4, udp4},
+			unmatched: [][]byte{tcp6, arp},
+		},
+		{
+			expr:      "!(tcp || udp) && !arp",
+			matches:   [][]byte{icmp4, icmp6},
+			unmatched: [][]byte{tcp4, udp6, arp},
+		},
+		{
+			expr:      "(udp and port 53) or (tcp and dst port 443 and host fd00::2)",
+			matches:   [][]byte{udp4, udp6, tcp6},
+			unmatched: [][]byte{tcp4, icmp6},
+		},
+	} {
+		test := test
+
+		t.Run(test.expr, func(t *testing.T) {
+			program, err := filter.Compile(test.expr, filter.LinkEthernet, snapLen)
+			require.NoError(t, err)
+
+			instructions, ok := bpf.Disassemble(program)
+			require.True(t, ok)
+
+			vm, err := bpf.NewVM(instructions)
+			require.NoError(t, err)
+
+			for _, packet := range test.matches {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+
+				assert.NotZero(t, n)
+			}
+
+			for _, packet := range test.unmatched {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+
+				assert.Zero(t, n)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"foo",
+		"tcp and",
+		"(tcp",
+		"port http",
+		"host example.com",
+		"ip host fd00::1",
+		"icmp port 53",
+		"src tcp",
+		"arp host 10.5.0.1",
+	} {
+		_, err := filter.Compile(expr, filter.LinkEthernet, snapLen)
+		assert.Error(t, err, "expression %q", expr)
+	}
+
+	for _, expr := range []string{
+		"arp",
+		"tcp or arp",
+	} {
+		_, err := filter.Compile(expr, filter.LinkRaw, snapLen)
+		assert.Error(t, err, "expression %q", expr)
+	}
+}
+
+func TestCompileRaw(t *testing.T) {
+	// raw IP links don't have the Ethernet header
+	raw := func(frame []byte) []byte {
+		return frame[14:]
+	}
+
+	var (
+		tcp4     = raw(ip4(6, "10.5.0.2", "10.5.0.3", 0, ports(40000, 443)))
+		udp4     = raw(ip4(17, "10.5.0.2", "8.8.8.8", 0, ports(5353, 53)))
+		fragment = raw(ip4(17, "10.5.0.2", "8.8.8.8", 100, ports(5353, 53)))
+		icmp4    = raw(ip4(1, "10.5.0.2", "10.5.0.3", 0, make([]byte, 8)))
+		tcp6     = raw(ip6(6, "fd00::2", "fd00::3", ports(40000, 443)))
+		udp6     = raw(ip6(17, "fd00::2", "2001:4860::8888", ports(5353, 53)))
+	)
+
+	for _, test := range []struct {
+		expr      string
+		matches   [][]byte
+		unmatched [][]byte
+	}{
+		{
+			expr:      "ip",
+			matches:   [][]byte{tcp4, udp4, icmp4},
+			unmatched: [][]byte{tcp6, udp6},
+		},
+		{
+			expr:      "ip6",
+			matches:   [][]byte{tcp6, udp6},
+			unmatched: [][]byte{tcp4, icmp4},
+		},
+		{
+			expr:      "port 53",
+			matches:   [][]byte{udp4, udp6},
+			unmatched: [][]byte{tcp4, tcp6, fragment, icmp4},
+		},
+		{
+			expr:      "tcp dst port 443 and src host 10.5.0.2",
+			matches:   [][]byte{tcp4},
+			unmatched: [][]byte{tcp6, udp4},
+		},
+		{
+			expr:      "dst net 2001:4860::/32",
+			matches:   [][]byte{udp6},
+			unmatched: [][]byte{tcp6, udp4},
+		},
+	} {
+		test := test
+
+		t.Run(test.expr, func(t *testing.T) {
+			program, err := filter.Compile(test.expr, filter.LinkRaw, snapLen)
+			require.NoError(t, err)
+
+			instructions, ok := bpf.Disassemble(program)
+			require.True(t, ok)
+
+			vm, err := bpf.NewVM(instructions)
+			require.NoError(t, err)
+
+			for _, packet := range test.matches {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+
+				assert.NotZero(t, n)
+			}
+
+			for _, packet := range test.unmatched {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+
+				assert.Zero(t, n)
+			}
+		})
+	}
+}
+
+func TestParseRaw(t *testing.T) {
+	// tcpdump -ddd ip
+	program, err := filter.ParseRaw("4\n40 0 0 12\n21 0 1 2048\n6 0 0 262144\n6 0 0 0\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, []bpf.RawInstruction{
+		{Op: 40, K: 12},
+		{Op: 21, Jf: 1, K: 2048},
+		{Op: 6, K: 262144},
+		{Op: 6},
+	}, program)
+
+	program, err = filter.ParseRaw("1,6 0 0 65535")
+	require.NoError(t, err)
+
+	assert.Equal(t, []bpf.RawInstruction{{Op: 6, K: 65535}}, program)
+
+	for _, raw := range []string{"", "2\n6 0 0 0", "1\n6 0 0", "x\n6 0 0 0"} {
+		_, err = filter.ParseRaw(raw)
+		assert.Error(t, err, "program %q", raw)
+	}
+}
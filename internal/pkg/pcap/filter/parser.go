@@ -0,0 +1,313 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package filter
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type direction int
+
+const (
+	dirAny direction = iota
+	dirSrc
+	dirDst
+)
+
+type protocol int
+
+const (
+	protoNone protocol = iota
+	protoIP
+	protoIP6
+	protoARP
+	protoTCP
+	protoUDP
+	protoICMP
+	protoICMP6
+)
+
+var protocols = map[string]protocol{
+	"ip":    protoIP,
+	"ip6":   protoIP6,
+	"arp":   protoARP,
+	"tcp":   protoTCP,
+	"udp":   protoUDP,
+	"icmp":  protoICMP,
+	"icmp6": protoICMP6,
+}
+
+// node is a node of the parsed filter expression.
+type node interface {
+	compile(c *compiler, t, f label)
+}
+
+type andNode struct{ left, right node }
+
+type orNode struct{ left, right node }
+
+type notNode struct{ expr node }
+
+type protoNode struct{ proto protocol }
+
+type hostNode struct {
+	proto protocol
+	dir   direction
+	ip    net.IP
+}
+
+type netNode struct {
+	proto protocol
+	dir   direction
+	net   *net.IPNet
+}
+
+type portNode struct {
+	proto protocol
+	dir   direction
+	port  uint16
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func tokenize(expr string) []string {
+	var tokens []string
+
+	expr = strings.NewReplacer("(", " ( ", ")", " ) ", "&&", " && ", "||", " || ").Replace(expr)
+
+	for _, field := range strings.Fields(expr) {
+		// "!" might be attached to the next token
+		for strings.HasPrefix(field, "!") {
+			tokens = append(tokens, "!")
+			field = field[1:]
+		}
+
+		if field != "" {
+			tokens = append(tokens, field)
+		}
+	}
+
+	return tokens
+}
+
+func parse(expr string) (node, error) {
+	p := &parser{
+		tokens: tokenize(expr),
+	}
+
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("filter expression is empty")
+	}
+
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.pos])
+	}
+
+	return n, nil
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *parser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of filter expression")
+	}
+
+	p.pos++
+
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "or" || p.peek() == "||" {
+		p.pos++
+
+		right, rightErr := p.parseAnd()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+
+		left = orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "and" || p.peek() == "&&" {
+		p.pos++
+
+		right, rightErr := p.parseUnary()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+
+		left = andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch p.peek() {
+	case "not", "!":
+		p.pos++
+
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{expr}, nil
+	case "(":
+		p.pos++
+
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if tok, _ := p.next(); tok != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+
+		return expr, nil
+	default:
+		return p.parsePrimitive()
+	}
+}
+
+// parsePrimitive parses `[proto] [src|dst] host|net|port <value>` or `proto`.
+//
+//nolint: gocyclo
+func (p *parser) parsePrimitive() (node, error) {
+	proto := protoNone
+
+	if pr, ok := protocols[p.peek()]; ok {
+		proto = pr
+		p.pos++
+	}
+
+	dir := dirAny
+
+	switch p.peek() {
+	case "src":
+		dir = dirSrc
+		p.pos++
+	case "dst":
+		dir = dirDst
+		p.pos++
+	}
+
+	switch p.peek() {
+	case "host", "net", "port":
+	default:
+		if dir != dirAny {
+			return nil, fmt.Errorf("expected host, net or port after direction")
+		}
+
+		if proto != protoNone {
+			return protoNode{proto}, nil
+		}
+
+		tok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("unexpected token %q", tok)
+	}
+
+	kind, _ := p.next()
+
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case "host":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid host address %q", value)
+		}
+
+		if err = checkFamily(proto, ip); err != nil {
+			return nil, err
+		}
+
+		return hostNode{proto, dir, ip}, nil
+	case "net":
+		_, ipNet, cidrErr := net.ParseCIDR(value)
+		if cidrErr != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", value, cidrErr)
+		}
+
+		if err = checkFamily(proto, ipNet.IP); err != nil {
+			return nil, err
+		}
+
+		return netNode{proto, dir, ipNet}, nil
+	default:
+		port, portErr := strconv.ParseUint(value, 10, 16)
+		if portErr != nil {
+			return nil, fmt.Errorf("invalid port %q", value)
+		}
+
+		if proto != protoNone && proto != protoTCP && proto != protoUDP {
+			return nil, fmt.Errorf("port qualifier is supported only for tcp and udp")
+		}
+
+		return portNode{proto, dir, uint16(port)}, nil
+	}
+}
+
+func checkFamily(proto protocol, ip net.IP) error {
+	switch proto {
+	case protoNone:
+		return nil
+	case protoIP:
+		if ip.To4() == nil {
+			return fmt.Errorf("address %s is not an IPv4 address", ip)
+		}
+
+		return nil
+	case protoIP6:
+		if ip.To4() != nil {
+			return fmt.Errorf("address %s is not an IPv6 address", ip)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("host and net qualifiers are supported only for ip and ip6")
+	}
+}
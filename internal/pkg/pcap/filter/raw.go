@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+// ParseRaw parses the BPF program in the `tcpdump -ddd` format: number of the instructions
+// followed by the instructions as `op jt jf k`, separated with newlines or commas.
+func ParseRaw(s string) ([]bpf.RawInstruction, error) {
+	lines := strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == ',' })
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("BPF program is empty")
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing instruction count: %w", err)
+	}
+
+	if count != len(lines)-1 {
+		return nil, fmt.Errorf("expected %d instructions, got %d", count, len(lines)-1)
+	}
+
+	program := make([]bpf.RawInstruction, 0, count)
+
+	for _, line := range lines[1:] {
+		var ins bpf.RawInstruction
+
+		if _, err = fmt.Sscanf(strings.TrimSpace(line), "%d %d %d %d", &ins.Op, &ins.Jt, &ins.Jf, &ins.K); err != nil {
+			return nil, fmt.Errorf("error parsing instruction %q: %w", line, err)
+		}
+
+		program = append(program, ins)
+	}
+
+	return program, nil
+}
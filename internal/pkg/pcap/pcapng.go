@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// Link types as defined in https://www.tcpdump.org/linktypes.html.
+const (
+	LinkTypeEthernet uint16 = 1
+	LinkTypeRaw      uint16 = 101
+)
+
+// pcap-ng block types and options.
+const (
+	blockTypeSectionHeader     uint32 = 0x0a0d0d0a
+	blockTypeInterfaceDesc     uint32 = 0x00000001
+	blockTypeEnhancedPacket    uint32 = 0x00000006
+	byteOrderMagic             uint32 = 0x1a2b3c4d
+	optionEndOfOpt             uint16 = 0
+	optionInterfaceName        uint16 = 2
+	optionInterfaceTSResol     uint16 = 9
+	timestampResolutionNanosec uint8  = 9
+)
+
+// Writer writes packets in pcap-ng format.
+//
+// Writer issues exactly one Write call per pcap-ng block, so that every
+// packet can be sent as a single message over the stream.
+type Writer struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewWriter writes the section header and the interface description, and returns
+// the Writer ready to accept the packets.
+func NewWriter(w io.Writer, iface string, linkType uint16, snapLen uint32) (*Writer, error) {
+	writer := &Writer{
+		w: w,
+	}
+
+	if err := writer.writeSectionHeader(); err != nil {
+		return nil, err
+	}
+
+	if err := writer.writeInterfaceDescription(iface, linkType, snapLen); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// WritePacket writes a single packet as the enhanced packet block.
+//
+// Length is the original length of the packet on the wire, data might be truncated.
+func (w *Writer) WritePacket(timestamp time.Time, length int, data []byte) error {
+	ts := uint64(timestamp.UnixNano())
+
+	body := make([]byte, 20, 20+pad4(len(data)))
+
+	binary.LittleEndian.PutUint32(body[0:], 0) // interface ID
+	binary.LittleEndian.PutUint32(body[4:], uint32(ts>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(ts))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(body[16:], uint32(length))
+
+	body = append(body, data...)
+	body = append(body, make([]byte, pad4(len(data))-len(data))...)
+
+	return w.writeBlock(blockTypeEnhancedPacket, body)
+}
+
+func (w *Writer) writeSectionHeader() error {
+	body := make([]byte, 16)
+
+	binary.LittleEndian.PutUint32(body[0:], byteOrderMagic)
+	binary.LittleEndian.PutUint16(body[4:], 1)                  // major version
+	binary.LittleEndian.PutUint16(body[6:], 0)                  // minor version
+	binary.LittleEndian.PutUint64(body[8:], 0xffffffffffffffff) // section length is not specified
+
+	return w.writeBlock(blockTypeSectionHeader, body)
+}
+
+func (w *Writer) writeInterfaceDescription(iface string, linkType uint16, snapLen uint32) error {
+	body := make([]byte, 8)
+
+	binary.LittleEndian.PutUint16(body[0:], linkType)
+	binary.LittleEndian.PutUint32(body[4:], snapLen)
+
+	if iface != "" {
+		body = appendOption(body, optionInterfaceName, []byte(iface))
+	}
+
+	body = appendOption(body, optionInterfaceTSResol, []byte{timestampResolutionNanosec})
+	body = appendOption(body, optionEndOfOpt, nil)
+
+	return w.writeBlock(blockTypeInterfaceDesc, body)
+}
+
+// writeBlock wraps the block body with the block type and total length and writes it out.
+func (w *Writer) writeBlock(blockType uint32, body []byte) error {
+	length := uint32(len(body) + 12)
+
+	w.buf = w.buf[:0]
+	w.buf = appendUint32(w.buf, blockType)
+	w.buf = appendUint32(w.buf, length)
+	w.buf = append(w.buf, body...)
+	w.buf = appendUint32(w.buf, length)
+
+	_, err := w.w.Write(w.buf)
+
+	return err
+}
+
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	var header [4]byte
+
+	binary.LittleEndian.PutUint16(header[0:], code)
+	binary.LittleEndian.PutUint16(header[2:], uint16(len(value)))
+
+	b = append(b, header[:]...)
+	b = append(b, value...)
+
+	return append(b, make([]byte, pad4(len(value))-len(value))...)
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+
+	binary.LittleEndian.PutUint32(buf[:], v)
+
+	return append(b, buf[:]...)
+}
+
+// pad4 rounds the length up to 32 bits.
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/pcap"
+)
+
+type block struct {
+	blockType uint32
+	body      []byte
+}
+
+func readBlocks(t *testing.T, data []byte) []block {
+	var blocks []block
+
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), 12)
+
+		blockType := binary.LittleEndian.Uint32(data[0:])
+		length := binary.LittleEndian.Uint32(data[4:])
+
+		require.Zero(t, length%4, "block length should be 32-bit aligned")
+		require.GreaterOrEqual(t, len(data), int(length))
+		require.Equal(t, length, binary.LittleEndian.Uint32(data[length-4:]), "trailing block length mismatch")
+
+		blocks = append(blocks, block{
+			blockType: blockType,
+			body:      data[8 : length-4],
+		})
+
+		data = data[length:]
+	}
+
+	return blocks
+}
+
+// countingWriter records the number of Write calls.
+type countingWriter struct {
+	bytes.Buffer
+
+	writes int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+
+	return w.Buffer.Write(p)
+}
+
+func TestWriter(t *testing.T) {
+	var buf countingWriter
+
+	w, err := pcap.NewWriter(&buf, "eth0", pcap.LinkTypeEthernet, 96)
+	require.NoError(t, err)
+
+	timestamp := time.Unix(1600000000, 123456789)
+	packet := []byte("hello, world!")
+
+	require.NoError(t, w.WritePacket(timestamp, 1500, packet))
+
+	assert.Equal(t, 3, buf.writes)
+
+	blocks := readBlocks(t, buf.Bytes())
+	require.Len(t, blocks, 3)
+
+	// section header
+	assert.Equal(t, uint32(0x0a0d0d0a), blocks[0].blockType)
+	assert.Equal(t, uint32(0x1a2b3c4d), binary.LittleEndian.Uint32(blocks[0].body[0:]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(blocks[0].body[4:]))
+
+	// interface description
+	assert.Equal(t, uint32(1), blocks[1].blockType)
+	assert.Equal(t, pcap.LinkTypeEthernet, binary.LittleEndian.Uint16(blocks[1].body[0:]))
+	assert.Equal(t, uint32(96), binary.LittleEndian.Uint32(blocks[1].body[4:]))
+	assert.Contains(t, string(blocks[1].body[8:]), "eth0")
+
+	// enhanced packet
+	assert.Equal(t, uint32(6), blocks[2].blockType)
+
+	body := blocks[2].body
+
+	assert.Equal(t, uint32(0), binary.LittleEndian.Uint32(body[0:]))
+
+	ts := uint64(binary.LittleEndian.Uint32(body[4:]))<<32 | uint64(binary.LittleEndian.Uint32(body[8:]))
+	assert.Equal(t, uint64(timestamp.UnixNano()), ts)
+
+	assert.Equal(t, uint32(len(packet)), binary.LittleEndian.Uint32(body[12:]))
+	assert.Equal(t, uint32(1500), binary.LittleEndian.Uint32(body[16:]))
+	assert.Equal(t, packet, body[20:20+len(packet)])
+	assert.Len(t, body, 20+16)
+}
@@ -11,14 +11,46 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/talos-systems/talos/pkg/safepath"
 )
 
+type untarOptions struct {
+	overwrite       bool
+	confineSymlinks bool
+}
+
+// UntarOption configures Untar.
+type UntarOption func(*untarOptions)
+
+// WithOverwrite replaces existing files and reuses existing directories.
+//
+// Default is to fail if the path already exists.
+func WithOverwrite() UntarOption {
+	return func(o *untarOptions) {
+		o.overwrite = true
+	}
+}
+
+// WithConfinedSymlinks rejects archive entries which resolve outside of the root path
+// via symlinks (either already present on the filesystem or extracted from the archive).
+func WithConfinedSymlinks() UntarOption {
+	return func(o *untarOptions) {
+		o.confineSymlinks = true
+	}
+}
+
 // Untar extracts .tar archive from r into filesystem under rootPath.
 //
 //nolint: gocyclo
-func Untar(ctx context.Context, r io.Reader, rootPath string) error {
+func Untar(ctx context.Context, r io.Reader, rootPath string, options ...UntarOption) error {
+	var opts untarOptions
+
+	for _, o := range options {
+		o(&opts)
+	}
+
 	tr := tar.NewReader(r)
 
 	for {
@@ -44,13 +76,25 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 
 		path := filepath.Join(rootPath, hdrPath)
 
+		if opts.confineSymlinks {
+			if err = checkConfined(rootPath, filepath.Dir(path)); err != nil {
+				return err
+			}
+		}
+
 		switch hdr.Typeflag {
 		case tar.TypeDir:
 			mode := hdr.FileInfo().Mode()
 			mode |= 0o700 // make rwx for the owner
 
 			if err = os.Mkdir(path, mode); err != nil {
-				return fmt.Errorf("error creating directory %q mode %s: %w", path, mode, err)
+				if !opts.overwrite || !os.IsExist(err) {
+					return fmt.Errorf("error creating directory %q mode %s: %w", path, mode, err)
+				}
+
+				if err = replaceNonDir(path, mode); err != nil {
+					return err
+				}
 			}
 
 			if err = os.Chmod(path, mode); err != nil {
@@ -58,6 +102,12 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 			}
 
 		case tar.TypeSymlink:
+			if opts.overwrite {
+				if err = removeExisting(path); err != nil {
+					return err
+				}
+			}
+
 			if err = os.Symlink(hdr.Linkname, path); err != nil {
 				return fmt.Errorf("error creating symlink %q -> %q: %w", path, hdr.Linkname, err)
 			}
@@ -65,6 +115,12 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 		default:
 			mode := hdr.FileInfo().Mode()
 
+			if opts.overwrite {
+				if err = removeExisting(path); err != nil {
+					return err
+				}
+			}
+
 			fp, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, mode)
 			if err != nil {
 				return fmt.Errorf("error creating file %q mode %s: %w", path, mode, err)
@@ -72,6 +128,8 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 
 			_, err = io.Copy(fp, tr)
 			if err != nil {
+				fp.Close() //nolint: errcheck
+
 				return fmt.Errorf("error copying data to %q: %w", path, err)
 			}
 
@@ -87,3 +145,66 @@ func Untar(ctx context.Context, r io.Reader, rootPath string) error {
 
 	return nil
 }
+
+// checkConfined verifies that path resolves to the location under rootPath.
+func checkConfined(rootPath, path string) error {
+	resolvedRoot, err := filepath.EvalSymlinks(rootPath)
+	if err != nil {
+		return fmt.Errorf("error resolving %q: %w", rootPath, err)
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return fmt.Errorf("error resolving %q: %w", path, err)
+	}
+
+	if resolved != resolvedRoot && !strings.HasPrefix(resolved, resolvedRoot+string(os.PathSeparator)) {
+		return fmt.Errorf("path %q resolves outside of %q", path, rootPath)
+	}
+
+	return nil
+}
+
+// removeExisting removes path if it exists and it's not a directory.
+func removeExisting(path string) error {
+	st, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error checking %q: %w", path, err)
+	}
+
+	if st.IsDir() {
+		return fmt.Errorf("error replacing %q: path is a directory", path)
+	}
+
+	if err = os.Remove(path); err != nil {
+		return fmt.Errorf("error removing %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// replaceNonDir replaces existing path with a directory if the path is not a directory.
+func replaceNonDir(path string, mode os.FileMode) error {
+	st, err := os.Lstat(path)
+	if err != nil {
+		return fmt.Errorf("error checking %q: %w", path, err)
+	}
+
+	if st.IsDir() {
+		return nil
+	}
+
+	if err = os.Remove(path); err != nil {
+		return fmt.Errorf("error removing %q: %w", path, err)
+	}
+
+	if err = os.Mkdir(path, mode); err != nil {
+		return fmt.Errorf("error creating directory %q mode %s: %w", path, mode, err)
+	}
+
+	return nil
+}
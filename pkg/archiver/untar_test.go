@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package archiver_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/archiver"
+)
+
+type UntarSuite struct {
+	suite.Suite
+
+	tmpDir string
+}
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	mode     int64
+	contents string
+	linkname string
+}
+
+func buildTar(entries []tarEntry) *bytes.Buffer {
+	var buf bytes.Buffer
+
+	tw := tar.NewWriter(&buf)
+
+	for _, entry := range entries {
+		hdr := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Mode:     entry.mode,
+			Size:     int64(len(entry.contents)),
+			Linkname: entry.linkname,
+		}
+
+		if entry.typeflag != tar.TypeReg {
+			hdr.Size = 0
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			panic(err)
+		}
+
+		if entry.typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte(entry.contents)); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		panic(err)
+	}
+
+	return &buf
+}
+
+func (suite *UntarSuite) SetupTest() {
+	var err error
+
+	suite.tmpDir, err = ioutil.TempDir("", "untar")
+	suite.Require().NoError(err)
+}
+
+func (suite *UntarSuite) TearDownTest() {
+	suite.Require().NoError(os.RemoveAll(suite.tmpDir))
+}
+
+func (suite *UntarSuite) TestExtract() {
+	buf := buildTar([]tarEntry{
+		{name: "bin", typeflag: tar.TypeDir, mode: 0o755},
+		{name: "bin/tool", typeflag: tar.TypeReg, mode: 0o755, contents: "ELF"},
+		{name: "config", typeflag: tar.TypeReg, mode: 0o600, contents: "secret"},
+		{name: "tool", typeflag: tar.TypeSymlink, linkname: "bin/tool"},
+	})
+
+	suite.Require().NoError(archiver.Untar(context.Background(), buf, suite.tmpDir))
+
+	st, err := os.Stat(filepath.Join(suite.tmpDir, "bin", "tool"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o755), st.Mode().Perm())
+
+	st, err = os.Stat(filepath.Join(suite.tmpDir, "config"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o600), st.Mode().Perm())
+
+	contents, err := ioutil.ReadFile(filepath.Join(suite.tmpDir, "tool"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("ELF", string(contents))
+}
+
+func (suite *UntarSuite) TestOverwrite() {
+	suite.Require().NoError(os.Mkdir(filepath.Join(suite.tmpDir, "bin"), 0o700))
+	suite.Require().NoError(ioutil.WriteFile(filepath.Join(suite.tmpDir, "bin", "tool"), []byte("old"), 0o644))
+
+	entries := []tarEntry{
+		{name: "bin", typeflag: tar.TypeDir, mode: 0o755},
+		{name: "bin/tool", typeflag: tar.TypeReg, mode: 0o755, contents: "new"},
+	}
+
+	suite.Require().Error(archiver.Untar(context.Background(), buildTar(entries), suite.tmpDir))
+
+	suite.Require().NoError(archiver.Untar(context.Background(), buildTar(entries), suite.tmpDir, archiver.WithOverwrite()))
+
+	contents, err := ioutil.ReadFile(filepath.Join(suite.tmpDir, "bin", "tool"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("new", string(contents))
+
+	st, err := os.Stat(filepath.Join(suite.tmpDir, "bin", "tool"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o755), st.Mode().Perm())
+}
+
+func (suite *UntarSuite) TestConfinedSymlinks() {
+	outside, err := ioutil.TempDir("", "untar-outside")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(outside) //nolint: errcheck
+
+	root := filepath.Join(suite.tmpDir, "root")
+	suite.Require().NoError(os.Mkdir(root, 0o755))
+
+	buf := buildTar([]tarEntry{
+		{name: "escape", typeflag: tar.TypeSymlink, linkname: outside},
+		{name: "escape/evil", typeflag: tar.TypeReg, mode: 0o644, contents: "evil"},
+	})
+
+	suite.Require().Error(archiver.Untar(context.Background(), buf, root, archiver.WithConfinedSymlinks()))
+
+	_, err = os.Stat(filepath.Join(outside, "evil"))
+	suite.Assert().True(os.IsNotExist(err))
+
+	buf = buildTar([]tarEntry{
+		{name: "dir", typeflag: tar.TypeDir, mode: 0o755},
+		{name: "link", typeflag: tar.TypeSymlink, linkname: "dir"},
+		{name: "link/file", typeflag: tar.TypeReg, mode: 0o644, contents: "ok"},
+	})
+
+	suite.Require().NoError(archiver.Untar(context.Background(), buf, root, archiver.WithConfinedSymlinks(), archiver.WithOverwrite()))
+
+	contents, err := ioutil.ReadFile(filepath.Join(root, "dir", "file"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("ok", string(contents))
+}
+
+func TestUntarSuite(t *testing.T) {
+	suite.Run(t, new(UntarSuite))
+}
@@ -15,28 +15,14 @@ import (
 
 // DefaultClusterChecks returns a set of default Talos cluster readiness checks.
 func DefaultClusterChecks() []ClusterCheck {
-	return []ClusterCheck{
+	return append([]ClusterCheck{
 		// wait for etcd to be healthy on all control plane nodes
 		func(cluster ClusterInfo) conditions.Condition {
 			return conditions.PollingCondition("etcd to be healthy", func(ctx context.Context) error {
 				return ServiceHealthAssertion(ctx, cluster, "etcd", WithNodeTypes(machine.TypeInit, machine.TypeControlPlane))
 			}, 5*time.Minute, 5*time.Second)
 		},
-		// wait for bootkube to finish on init node
-		func(cluster ClusterInfo) conditions.Condition {
-			return conditions.PollingCondition("bootkube to finish", func(ctx context.Context) error {
-				err := ServiceStateAssertion(ctx, cluster, "bootkube", "Finished", "Skipped")
-				if err != nil {
-					if errors.Is(err, ErrServiceNotFound) {
-						return nil
-					}
-
-					return err
-				}
-
-				return nil
-			}, 5*time.Minute, 5*time.Second)
-		},
+		bootkubeFinishedCheck,
 		// wait for apid to be ready on all the nodes
 		func(cluster ClusterInfo) conditions.Condition {
 			return conditions.PollingCondition("apid to be ready", func(ctx context.Context) error {
@@ -49,12 +35,30 @@ func DefaultClusterChecks() []ClusterCheck {
 				return ServiceHealthAssertion(ctx, cluster, "kubelet", WithNodeTypes(machine.TypeInit, machine.TypeControlPlane))
 			}, 5*time.Minute, 5*time.Second)
 		},
-		// wait for all nodes to finish booting
+		allNodesBootedCheck,
+	}, K8sClusterChecks()...)
+}
+
+// NodeReadinessClusterChecks returns a set of Talos cluster readiness checks which rely
+// on the node readiness reported by each node instead of checking individual services.
+//
+// NodeReadinessClusterChecks requires nodes to support NodeReady API.
+func NodeReadinessClusterChecks() []ClusterCheck {
+	return append([]ClusterCheck{
+		bootkubeFinishedCheck,
+		// wait for all the nodes to report ready
 		func(cluster ClusterInfo) conditions.Condition {
-			return conditions.PollingCondition("all nodes to finish boot sequence", func(ctx context.Context) error {
-				return AllNodesBootedAssertion(ctx, cluster)
+			return conditions.PollingCondition("all nodes to report ready", func(ctx context.Context) error {
+				return NodesReadyAssertion(ctx, cluster)
 			}, 5*time.Minute, 5*time.Second)
 		},
+		allNodesBootedCheck,
+	}, K8sClusterChecks()...)
+}
+
+// K8sClusterChecks returns a set of Kubernetes readiness checks.
+func K8sClusterChecks() []ClusterCheck {
+	return []ClusterCheck{
 		// wait for all the nodes to report in at k8s level
 		func(cluster ClusterInfo) conditions.Condition {
 			return conditions.PollingCondition("all k8s nodes to report", func(ctx context.Context) error {
@@ -94,6 +98,29 @@ func DefaultClusterChecks() []ClusterCheck {
 	}
 }
 
+// wait for bootkube to finish on init node.
+func bootkubeFinishedCheck(cluster ClusterInfo) conditions.Condition {
+	return conditions.PollingCondition("bootkube to finish", func(ctx context.Context) error {
+		err := ServiceStateAssertion(ctx, cluster, "bootkube", "Finished", "Skipped")
+		if err != nil {
+			if errors.Is(err, ErrServiceNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		return nil
+	}, 5*time.Minute, 5*time.Second)
+}
+
+// wait for all nodes to finish booting.
+func allNodesBootedCheck(cluster ClusterInfo) conditions.Condition {
+	return conditions.PollingCondition("all nodes to finish boot sequence", func(ctx context.Context) error {
+		return AllNodesBootedAssertion(ctx, cluster)
+	}, 5*time.Minute, 5*time.Second)
+}
+
 // ExtraClusterChecks returns a set of additional Talos cluster readiness checks which work only for newer versions of Talos.
 //
 // ExtraClusterChecks can't be used reliably in upgrade tests, as older versions might not pass the checks.
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// NodesReadyAssertion checks whether all the nodes report ready based on the health of their services.
+func NodesReadyAssertion(ctx context.Context, cluster ClusterInfo) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodes := cluster.Nodes()
+	nodesCtx := client.WithNodes(ctx, nodes...)
+
+	resp, err := cli.NodeReady(nodesCtx)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Messages) != len(nodes) {
+		return fmt.Errorf("expected a response with %d node(s), got %d", len(nodes), len(resp.Messages))
+	}
+
+	// sort responses so that errors returned are consistent
+	sort.Slice(resp.Messages, func(i, j int) bool {
+		return resp.Messages[i].GetMetadata().GetHostname() < resp.Messages[j].GetMetadata().GetHostname()
+	})
+
+	var multiErr *multierror.Error
+
+	for _, msg := range resp.Messages {
+		if msg.Ready {
+			continue
+		}
+
+		multiErr = multierror.Append(multiErr, fmt.Errorf("%s: node is not ready: %s", msg.GetMetadata().GetHostname(), strings.Join(msg.Reasons, ", ")))
+	}
+
+	return multiErr.ErrorOrNil()
+}
@@ -0,0 +1,447 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/talos-systems/go-retry/retry"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+const (
+	kubelet = "kubelet"
+
+	nodeRebootTimeout = 15 * time.Minute
+	podUpdateTimeout  = 10 * time.Minute
+)
+
+// nodeInfo is a Kubernetes node being upgraded.
+type nodeInfo struct {
+	name         string
+	address      string
+	controlPlane bool
+}
+
+// imageChange is a planned update of the component image in the machine config.
+type imageChange struct {
+	component string
+	from      string
+	to        string
+}
+
+// talosManagedUpgrade upgrades the control plane rendered by Talos as static pods.
+//
+// Machine configuration is patched node by node: control plane nodes get new images
+// of the control plane components and kube-proxy, and every node gets new kubelet image.
+// kube-proxy daemonset is updated from the bootstrap manifests rendered by the control plane nodes.
+// Each node reboots to apply the configuration, and the upgrade proceeds to the next node
+// only after the components on the node are updated.
+//
+//nolint: gocyclo
+func talosManagedUpgrade(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions) error {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error building K8s client: %w", err)
+	}
+
+	talosClient, err := cluster.Client()
+	if err != nil {
+		return fmt.Errorf("error building Talos API client: %w", err)
+	}
+
+	nodes, err := listNodes(ctx, clientset)
+	if err != nil {
+		return err
+	}
+
+	if options.DryRun {
+		fmt.Printf("dry run: no changes will be applied\n")
+	}
+
+	for _, node := range nodes {
+		if err = upgradeNodeConfig(ctx, talosClient, clientset, node, options); err != nil {
+			return fmt.Errorf("error upgrading node %q: %w", node.name, err)
+		}
+	}
+
+	if options.DryRun {
+		return nil
+	}
+
+	// kube-proxy is rendered from the machine config as a bootstrap manifest, so it is updated
+	// by the control plane nodes once their machine config is patched
+	fmt.Printf("waiting for daemonset %q to be updated from the bootstrap manifests\n", kubeProxy)
+
+	if err = retry.Constant(podUpdateTimeout, retry.WithUnits(10*time.Second)).Retry(func() error {
+		daemonset, dsErr := clientset.AppsV1().DaemonSets(namespace).Get(ctx, kubeProxy, metav1.GetOptions{})
+		if dsErr != nil {
+			return retry.ExpectedError(fmt.Errorf("error fetching daemonset %q: %w", kubeProxy, dsErr))
+		}
+
+		return checkDaemonsetRollout(daemonset, "v"+options.ToVersion)
+	}); err != nil {
+		return err
+	}
+
+	return verifyVersions(ctx, clientset, nodes, options)
+}
+
+// checkDaemonsetRollout verifies that the daemonset runs the image of the version on all the nodes.
+func checkDaemonsetRollout(daemonset *appsv1.DaemonSet, version string) error {
+	if len(daemonset.Spec.Template.Spec.Containers) != 1 {
+		return retry.UnexpectedError(fmt.Errorf("unexpected number of containers in daemonset %q: %d", daemonset.Name, len(daemonset.Spec.Template.Spec.Containers)))
+	}
+
+	if image := daemonset.Spec.Template.Spec.Containers[0].Image; imageTag(image) != version {
+		return retry.ExpectedError(fmt.Errorf("expected daemonset %q image version %q, got image %q", daemonset.Name, version, image))
+	}
+
+	status := daemonset.Status
+
+	if status.ObservedGeneration < daemonset.Generation ||
+		status.UpdatedNumberScheduled != status.DesiredNumberScheduled ||
+		status.NumberAvailable != status.DesiredNumberScheduled {
+		return retry.ExpectedError(fmt.Errorf("daemonset %q rollout is in progress: %d/%d updated, %d/%d available", daemonset.Name,
+			status.UpdatedNumberScheduled, status.DesiredNumberScheduled, status.NumberAvailable, status.DesiredNumberScheduled))
+	}
+
+	return nil
+}
+
+// listNodes returns the cluster nodes, control plane nodes first.
+func listNodes(ctx context.Context, clientset *kubernetes.Clientset) ([]nodeInfo, error) {
+	nodeList, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	nodes := make([]nodeInfo, 0, len(nodeList.Items))
+
+	for _, item := range nodeList.Items {
+		n := nodeInfo{
+			name: item.Name,
+		}
+
+		_, n.controlPlane = item.Labels[constants.LabelNodeRoleMaster]
+
+		for _, address := range item.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				n.address = address.Address
+
+				break
+			}
+		}
+
+		if n.address == "" {
+			return nil, fmt.Errorf("node %q doesn't have an internal IP address", n.name)
+		}
+
+		nodes = append(nodes, n)
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].controlPlane != nodes[j].controlPlane {
+			return nodes[i].controlPlane
+		}
+
+		return nodes[i].name < nodes[j].name
+	})
+
+	return nodes, nil
+}
+
+// upgradeNodeConfig patches the machine config of the node with the new images and waits for the node to pick them up.
+//
+//nolint: gocyclo
+func upgradeNodeConfig(ctx context.Context, c *client.Client, clientset *kubernetes.Clientset, node nodeInfo, options UpgradeOptions) error {
+	nodeCtx := client.WithNodes(ctx, node.address)
+
+	cfg, err := cluster.ReadConfig(nodeCtx, c)
+	if err != nil {
+		return fmt.Errorf("error reading machine config: %w", err)
+	}
+
+	changes, err := patchImages(cfg, node.controlPlane, options)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("%s: machine config is up to date\n", node.name)
+
+		return nil
+	}
+
+	for _, change := range changes {
+		fmt.Printf("%s: %s: %q -> %q\n", node.name, change.component, change.from, change.to)
+	}
+
+	if options.DryRun {
+		return nil
+	}
+
+	bootID, err := cluster.ReadBootID(nodeCtx, c)
+	if err != nil {
+		return fmt.Errorf("error reading boot ID: %w", err)
+	}
+
+	data, err := cfg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: applying machine config\n", node.name)
+
+	if _, err = c.ApplyConfiguration(nodeCtx, &machineapi.ApplyConfigurationRequest{
+		Data: data,
+	}); err != nil {
+		return fmt.Errorf("error applying machine config: %w", err)
+	}
+
+	fmt.Printf("%s: waiting for the node to reboot\n", node.name)
+
+	if err = cluster.WaitRebooted(ctx, node.address, bootID, nodeRebootTimeout, func(ctx context.Context) (string, error) {
+		return cluster.ReadBootID(client.WithNodes(ctx, node.address), c)
+	}); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: waiting for the components to be updated\n", node.name)
+
+	return retry.Constant(podUpdateTimeout, retry.WithUnits(10*time.Second)).Retry(func() error {
+		return checkNodeVersions(ctx, clientset, node, options)
+	})
+}
+
+// patchImages updates the component images in the machine config returning the list of changes.
+//
+// Only the tag of the image is updated, so the images pulled from the custom repositories are kept.
+//
+//nolint: gocyclo
+func patchImages(cfg *v1alpha1.Config, controlPlane bool, options UpgradeOptions) ([]imageChange, error) {
+	var changes []imageChange
+
+	update := func(component, image, defaultRepository string, set func(string)) error {
+		if image == "" {
+			// image is not set in the machine config, so the node runs the default image of the version being upgraded from
+			image = fmt.Sprintf("%s:v%s", defaultRepository, options.FromVersion)
+		}
+
+		upgraded, err := upgradeImage(image, options)
+		if err != nil {
+			return fmt.Errorf("error upgrading %s image: %w", component, err)
+		}
+
+		if upgraded == image {
+			return nil
+		}
+
+		set(upgraded)
+
+		changes = append(changes, imageChange{
+			component: component,
+			from:      image,
+			to:        upgraded,
+		})
+
+		return nil
+	}
+
+	if cfg.MachineConfig.MachineKubelet == nil {
+		cfg.MachineConfig.MachineKubelet = &v1alpha1.KubeletConfig{}
+	}
+
+	if err := update(kubelet, cfg.MachineConfig.MachineKubelet.KubeletImage, constants.KubeletImage, func(image string) {
+		cfg.MachineConfig.MachineKubelet.KubeletImage = image
+	}); err != nil {
+		return nil, err
+	}
+
+	if !controlPlane {
+		return changes, nil
+	}
+
+	if cfg.ClusterConfig.APIServerConfig == nil {
+		cfg.ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{}
+	}
+
+	if err := update(kubeAPIServer, cfg.ClusterConfig.APIServerConfig.ContainerImage, archRepository(constants.KubernetesAPIServerImage, options), func(image string) {
+		cfg.ClusterConfig.APIServerConfig.ContainerImage = image
+	}); err != nil {
+		return nil, err
+	}
+
+	if cfg.ClusterConfig.ControllerManagerConfig == nil {
+		cfg.ClusterConfig.ControllerManagerConfig = &v1alpha1.ControllerManagerConfig{}
+	}
+
+	if err := update(kubeControllerManager, cfg.ClusterConfig.ControllerManagerConfig.ContainerImage, archRepository(constants.KubernetesControllerManagerImage, options), func(image string) {
+		cfg.ClusterConfig.ControllerManagerConfig.ContainerImage = image
+	}); err != nil {
+		return nil, err
+	}
+
+	if cfg.ClusterConfig.SchedulerConfig == nil {
+		cfg.ClusterConfig.SchedulerConfig = &v1alpha1.SchedulerConfig{}
+	}
+
+	if err := update(kubeScheduler, cfg.ClusterConfig.SchedulerConfig.ContainerImage, archRepository(constants.KubernetesSchedulerImage, options), func(image string) {
+		cfg.ClusterConfig.SchedulerConfig.ContainerImage = image
+	}); err != nil {
+		return nil, err
+	}
+
+	if cfg.ClusterConfig.ProxyConfig == nil {
+		cfg.ClusterConfig.ProxyConfig = &v1alpha1.ProxyConfig{}
+	}
+
+	if err := update(kubeProxy, cfg.ClusterConfig.ProxyConfig.ContainerImage, archRepository(constants.KubernetesProxyImage, options), func(image string) {
+		cfg.ClusterConfig.ProxyConfig.ContainerImage = image
+	}); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// upgradeImage replaces the tag of the image with the version to upgrade to.
+//
+// Image which is already upgraded is returned as is, image of any other version is rejected.
+func upgradeImage(image string, options UpgradeOptions) (string, error) {
+	ref, err := reference.Parse(image)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image %q: %w", image, err)
+	}
+
+	named, ok := ref.(reference.Named)
+	if !ok {
+		return "", fmt.Errorf("image %q doesn't have a repository", image)
+	}
+
+	switch imageTag(image) {
+	case "v" + options.ToVersion:
+		return image, nil
+	case "v" + options.FromVersion:
+	default:
+		return "", fmt.Errorf("image %q doesn't match the version to upgrade from %q", image, options.FromVersion)
+	}
+
+	// digest of the old version is dropped along with the tag
+	upgraded, err := reference.WithTag(reference.TrimNamed(named), "v"+options.ToVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return upgraded.String(), nil
+}
+
+// imageTag returns the tag of the image, or an empty string if the image is not tagged.
+func imageTag(image string) string {
+	ref, err := reference.Parse(image)
+	if err != nil {
+		return ""
+	}
+
+	if tagged, ok := ref.(reference.Tagged); ok {
+		return tagged.Tag()
+	}
+
+	return ""
+}
+
+// checkNodeVersions verifies that the kubelet and the control plane static pods on the node run the new version.
+func checkNodeVersions(ctx context.Context, clientset *kubernetes.Clientset, node nodeInfo, options UpgradeOptions) error {
+	n, err := clientset.CoreV1().Nodes().Get(ctx, node.name, metav1.GetOptions{})
+	if err != nil {
+		return retry.ExpectedError(fmt.Errorf("error fetching node: %w", err))
+	}
+
+	expectedVersion := "v" + options.ToVersion
+
+	if n.Status.NodeInfo.KubeletVersion != expectedVersion {
+		return retry.ExpectedError(fmt.Errorf("%s: expected kubelet version %q, got %q", node.name, expectedVersion, n.Status.NodeInfo.KubeletVersion))
+	}
+
+	if !node.controlPlane {
+		return nil
+	}
+
+	for _, component := range []string{kubeAPIServer, kubeControllerManager, kubeScheduler} {
+		var pod *corev1.Pod
+
+		// static pods are mirrored to the API server as <name>-<node name>
+		pod, err = clientset.CoreV1().Pods(namespace).Get(ctx, fmt.Sprintf("%s-%s", component, node.name), metav1.GetOptions{})
+		if err != nil {
+			return retry.ExpectedError(fmt.Errorf("%s: error fetching %s pod: %w", node.name, component, err))
+		}
+
+		if len(pod.Spec.Containers) != 1 {
+			return retry.UnexpectedError(fmt.Errorf("%s: unexpected number of containers in %s pod: %d", node.name, component, len(pod.Spec.Containers)))
+		}
+
+		if image := pod.Spec.Containers[0].Image; imageTag(image) != expectedVersion {
+			return retry.ExpectedError(fmt.Errorf("%s: expected %s image version %q, got image %q", node.name, component, expectedVersion, image))
+		}
+
+		ready := false
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				ready = true
+
+				break
+			}
+		}
+
+		if !ready {
+			return retry.ExpectedError(fmt.Errorf("%s: %s pod is not ready", node.name, component))
+		}
+	}
+
+	return nil
+}
+
+// verifyVersions checks that all the components across the cluster run the new version.
+func verifyVersions(ctx context.Context, clientset *kubernetes.Clientset, nodes []nodeInfo, options UpgradeOptions) error {
+	fmt.Printf("verifying component versions\n")
+
+	return retry.Constant(podUpdateTimeout, retry.WithUnits(10*time.Second)).Retry(func() error {
+		version, err := clientset.Discovery().ServerVersion()
+		if err != nil {
+			return retry.ExpectedError(fmt.Errorf("error fetching API server version: %w", err))
+		}
+
+		if version.GitVersion != "v"+options.ToVersion {
+			return retry.ExpectedError(fmt.Errorf("expected API server version %q, got %q", "v"+options.ToVersion, version.GitVersion))
+		}
+
+		for _, node := range nodes {
+			if err = checkNodeVersions(ctx, clientset, node, options); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// archRepository returns the repository of the architecture specific default image.
+func archRepository(repository string, options UpgradeOptions) string {
+	return fmt.Sprintf("%s-%s", repository, options.Architecture)
+}
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestPatchImages(t *testing.T) {
+	options := UpgradeOptions{
+		FromVersion:  "1.20.1",
+		ToVersion:    "1.20.2",
+		Architecture: "amd64",
+	}
+
+	cfg := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineKubelet: &v1alpha1.KubeletConfig{
+				KubeletImage: "registry.local:5000/talos-systems/kubelet:v1.20.1",
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			APIServerConfig: &v1alpha1.APIServerConfig{
+				ContainerImage: "k8s.gcr.io/kube-apiserver-amd64:v1.20.1@sha256:2d8de0e4e4dc62a2dbf6bee9bd1b3a4ea3b7bf0ee4e4c1a1ec1a0b2d2e7c0f11",
+			},
+			SchedulerConfig: &v1alpha1.SchedulerConfig{
+				ContainerImage: "k8s.gcr.io/kube-scheduler-amd64:v1.20.2",
+			},
+		},
+	}
+
+	changes, err := patchImages(cfg, false, options)
+	require.NoError(t, err)
+
+	// custom repository is kept
+	assert.Equal(t, []imageChange{
+		{component: kubelet, from: "registry.local:5000/talos-systems/kubelet:v1.20.1", to: "registry.local:5000/talos-systems/kubelet:v1.20.2"},
+	}, changes)
+	assert.Equal(t, "k8s.gcr.io/kube-apiserver-amd64:v1.20.1@sha256:2d8de0e4e4dc62a2dbf6bee9bd1b3a4ea3b7bf0ee4e4c1a1ec1a0b2d2e7c0f11", cfg.ClusterConfig.APIServerConfig.ContainerImage)
+
+	changes, err = patchImages(cfg, true, options)
+	require.NoError(t, err)
+
+	components := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		components = append(components, change.component)
+	}
+
+	// kubelet and scheduler are already up to date
+	assert.Equal(t, []string{kubeAPIServer, kubeControllerManager, kubeProxy}, components)
+
+	// digest of the old version is dropped, images which are not set are upgraded from the default ones
+	assert.Equal(t, "k8s.gcr.io/kube-apiserver-amd64:v1.20.2", cfg.ClusterConfig.APIServerConfig.ContainerImage)
+	assert.Equal(t, "k8s.gcr.io/kube-controller-manager-amd64:v1.20.2", cfg.ClusterConfig.ControllerManagerConfig.ContainerImage)
+	assert.Equal(t, "k8s.gcr.io/kube-proxy-amd64:v1.20.2", cfg.ClusterConfig.ProxyConfig.ContainerImage)
+
+	changes, err = patchImages(cfg, true, options)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestPatchImagesVersionMismatch(t *testing.T) {
+	cfg := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineKubelet: &v1alpha1.KubeletConfig{
+				KubeletImage: "ghcr.io/talos-systems/kubelet:v1.19.4",
+			},
+		},
+	}
+
+	_, err := patchImages(cfg, false, UpgradeOptions{
+		FromVersion: "1.20.1",
+		ToVersion:   "1.20.2",
+	})
+	assert.EqualError(t, err, `error upgrading kubelet image: image "ghcr.io/talos-systems/kubelet:v1.19.4" doesn't match the version to upgrade from "1.20.1"`)
+
+	// config is not changed
+	assert.Equal(t, "ghcr.io/talos-systems/kubelet:v1.19.4", cfg.MachineConfig.MachineKubelet.KubeletImage)
+}
+
+func TestCheckDaemonsetRollout(t *testing.T) {
+	daemonset := func(image string, generation, observedGeneration int64, desired, updated, available int32) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       kubeProxy,
+				Generation: generation,
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  kubeProxy,
+								Image: image,
+							},
+						},
+					},
+				},
+			},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     observedGeneration,
+				DesiredNumberScheduled: desired,
+				UpdatedNumberScheduled: updated,
+				NumberAvailable:        available,
+			},
+		}
+	}
+
+	const (
+		image   = "registry.local/kube-proxy-amd64:v1.20.2"
+		version = "v1.20.2"
+	)
+
+	for _, tt := range []struct {
+		name          string
+		daemonset     *appsv1.DaemonSet
+		expectedError string
+	}{
+		{
+			name:      "rolled out",
+			daemonset: daemonset(image, 2, 2, 3, 3, 3),
+		},
+		{
+			name:          "not updated from manifests yet",
+			daemonset:     daemonset("registry.local/kube-proxy-amd64:v1.20.1", 1, 1, 3, 3, 3),
+			expectedError: `expected daemonset "kube-proxy" image version "v1.20.2", got image "registry.local/kube-proxy-amd64:v1.20.1"`,
+		},
+		{
+			name:          "not observed",
+			daemonset:     daemonset(image, 2, 1, 3, 3, 3),
+			expectedError: `daemonset "kube-proxy" rollout is in progress: 3/3 updated, 3/3 available`,
+		},
+		{
+			name:          "rolling out",
+			daemonset:     daemonset(image, 2, 2, 3, 1, 2),
+			expectedError: `daemonset "kube-proxy" rollout is in progress: 1/3 updated, 2/3 available`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDaemonsetRollout(tt.daemonset, version)
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
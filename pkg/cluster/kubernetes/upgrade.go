@@ -43,16 +43,38 @@ type UpgradeOptions struct {
 
 	ControlPlaneEndpoint string
 
+	// DryRun only prints the planned changes without applying them.
+	DryRun bool
+
 	extraUpdaters                []daemonsetUpdater
 	podCheckpointerExtraUpdaters []daemonsetUpdater
 }
 
+// UpgradeProvider are the cluster interfaces required by the upgrade process.
+type UpgradeProvider interface {
+	cluster.ClientProvider
+	cluster.K8sProvider
+}
+
 type daemonsetUpdater func(ds string, daemonset *appsv1.DaemonSet) error
 
 // Upgrade the Kubernetes control plane.
 //
+// Self-hosted control plane is upgraded by patching the control plane daemonsets,
+// while the control plane managed by Talos as static pods is upgraded by patching
+// machine configuration of the nodes.
+//
 //nolint: gocyclo
-func Upgrade(ctx context.Context, cluster cluster.K8sProvider, options UpgradeOptions) error {
+func Upgrade(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions) error {
+	selfHosted, err := isSelfHosted(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	if !selfHosted {
+		return talosManagedUpgrade(ctx, cluster, options)
+	}
+
 	switch {
 	case strings.HasPrefix(options.FromVersion, "1.18.") && strings.HasPrefix(options.ToVersion, "1.19."):
 		return hyperkubeUpgrade(ctx, cluster, options)
@@ -62,15 +84,19 @@ func Upgrade(ctx context.Context, cluster cluster.K8sProvider, options UpgradeOp
 		options.extraUpdaters = append(options.extraUpdaters, addControlPlaneToleration())
 		options.podCheckpointerExtraUpdaters = append(options.podCheckpointerExtraUpdaters, addControlPlaneToleration())
 
-		serviceAccountUpdater, err := kubeAPIServerServiceAccountPatch(options)
+		var serviceAccountUpdater daemonsetUpdater
+
+		serviceAccountUpdater, err = kubeAPIServerServiceAccountPatch(options)
 		if err != nil {
 			return err
 		}
 
 		options.extraUpdaters = append(options.extraUpdaters, serviceAccountUpdater)
 
-		if err = serviceAccountSecretsUpdate(ctx, cluster); err != nil {
-			return err
+		if !options.DryRun {
+			if err = serviceAccountSecretsUpdate(ctx, cluster); err != nil {
+				return err
+			}
 		}
 
 		return hyperkubeUpgrade(ctx, cluster, options)
@@ -81,6 +107,25 @@ func Upgrade(ctx context.Context, cluster cluster.K8sProvider, options UpgradeOp
 	}
 }
 
+// isSelfHosted checks whether the control plane runs as self-hosted daemonsets.
+func isSelfHosted(ctx context.Context, cluster cluster.K8sProvider) (bool, error) {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error building K8s client: %w", err)
+	}
+
+	_, err = clientset.AppsV1().DaemonSets(namespace).Get(ctx, kubeAPIServer, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error fetching daemonset: %w", err)
+	}
+
+	return true, nil
+}
+
 // hyperkubeUpgrade upgrades from hyperkube-based to distroless images in 1.19.
 func hyperkubeUpgrade(ctx context.Context, cluster cluster.K8sProvider, options UpgradeOptions) error {
 	clientset, err := cluster.K8sClient(ctx)
@@ -88,6 +133,16 @@ func hyperkubeUpgrade(ctx context.Context, cluster cluster.K8sProvider, options
 		return fmt.Errorf("error building K8s client: %w", err)
 	}
 
+	daemonsets := []string{kubeAPIServer, kubeControllerManager, kubeScheduler, kubeProxy}
+
+	if options.DryRun {
+		for _, ds := range daemonsets {
+			fmt.Printf("would update daemonset %q to version %q\n", ds, options.ToVersion)
+		}
+
+		return nil
+	}
+
 	if err = podCheckpointerGracePeriod(ctx, clientset, "0m"); err != nil {
 		return fmt.Errorf("error setting pod-checkpointer grace period: %w", err)
 	}
@@ -97,8 +152,6 @@ func hyperkubeUpgrade(ctx context.Context, cluster cluster.K8sProvider, options
 	fmt.Printf("sleeping %s to let the pod-checkpointer self-checkpoint be updated\n", graceTimeout.String())
 	time.Sleep(graceTimeout)
 
-	daemonsets := []string{kubeAPIServer, kubeControllerManager, kubeScheduler, kubeProxy}
-
 	for _, ds := range daemonsets {
 		if err = hyperkubeUpgradeDs(ctx, clientset, ds, options); err != nil {
 			return fmt.Errorf("failed updating daemonset %q: %w", ds, err)
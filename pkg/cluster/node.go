@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/go-retry/retry"
+
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// ReadFile reads the whole file from the node.
+func ReadFile(ctx context.Context, c *client.Client, path string) ([]byte, error) {
+	// rebooting node might not answer for a long time
+	reqCtx, reqCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer reqCtxCancel()
+
+	reader, errCh, err := c.Read(reqCtx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close() //nolint: errcheck
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(ioutil.Discard, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for err = range errCh {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return body, nil
+}
+
+// ReadBootID reads boot ID of the node, boot ID changes on every reboot.
+func ReadBootID(ctx context.Context, c *client.Client) (string, error) {
+	body, err := ReadFile(ctx, c, "/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// ReadConfig reads current machine config of the node.
+func ReadConfig(ctx context.Context, c *client.Client) (*v1alpha1.Config, error) {
+	data, err := ReadFile(ctx, c, constants.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, ok := provider.(*v1alpha1.Config)
+	if !ok {
+		return nil, fmt.Errorf("unsupported machine config type %T", provider)
+	}
+
+	return cfg, nil
+}
+
+// WaitRebooted waits for the node boot ID to change.
+//
+// Errors returned by bootIDFunc are retried, as the API is unresponsive during the reboot.
+func WaitRebooted(ctx context.Context, node, bootIDBefore string, timeout time.Duration, bootIDFunc func(context.Context) (string, error)) error {
+	return retry.Constant(timeout, retry.WithUnits(5*time.Second)).Retry(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		bootIDAfter, err := bootIDFunc(ctx)
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		if bootIDAfter == bootIDBefore {
+			return retry.ExpectedError(fmt.Errorf("node %q hasn't rebooted yet", node))
+		}
+
+		return nil
+	})
+}
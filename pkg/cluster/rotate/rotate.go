@@ -0,0 +1,400 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package rotate implements rotation of the Talos and Kubernetes certificate authorities.
+package rotate
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/talos-systems/crypto/x509"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// Options configures CA rotation.
+type Options struct {
+	// TalosConfig is the client configuration, it is updated as the rotation progresses.
+	TalosConfig *clientconfig.Config
+	// TalosConfigPath is the path TalosConfig is saved to.
+	TalosConfigPath string
+	// ContextName is the name of the TalosConfig context to use and update (defaults to the current one).
+	ContextName string
+
+	// KubeconfigPath is the path to write updated admin kubeconfig to (if set).
+	KubeconfigPath string
+
+	// StatePath is the path to the state file used to resume the rotation.
+	StatePath string
+
+	RotateTalosCA      bool
+	RotateKubernetesCA bool
+
+	// Until stops the rotation after the step is completed (runs all the steps if empty).
+	Until Step
+
+	// NodeTimeout is the timeout for a single node to pick up the new configuration.
+	NodeTimeout time.Duration
+
+	Log func(format string, args ...interface{})
+}
+
+// CA rotates Talos and/or Kubernetes CA of the cluster.
+//
+// Each step is idempotent, progress is saved to the state file, so the rotation
+// can be resumed after failure.
+//
+//nolint: gocyclo
+func CA(ctx context.Context, info cluster.Info, options Options) error {
+	if !options.RotateTalosCA && !options.RotateKubernetesCA {
+		return fmt.Errorf("nothing to rotate")
+	}
+
+	if options.ContextName == "" {
+		options.ContextName = options.TalosConfig.Context
+	}
+
+	if _, ok := options.TalosConfig.Contexts[options.ContextName]; !ok {
+		return fmt.Errorf("context %q is not defined", options.ContextName)
+	}
+
+	if options.Log == nil {
+		options.Log = func(string, ...interface{}) {}
+	}
+
+	steps, err := StepsUntil(options.Until)
+	if err != nil {
+		return err
+	}
+
+	state, err := LoadState(options.StatePath)
+	if err != nil {
+		return err
+	}
+
+	if err = state.CheckCAs(options.RotateTalosCA, options.RotateKubernetesCA); err != nil {
+		return fmt.Errorf("state %q: %w", options.StatePath, err)
+	}
+
+	r := &rotator{
+		info:    info,
+		options: options,
+		state:   state,
+	}
+
+	r.updateNode = r.patchNode
+	r.updateKubeconfig = r.fetchKubeconfig
+
+	for _, step := range steps {
+		if state.IsCompleted(step) {
+			options.Log("step %q is already completed, skipping", step)
+
+			continue
+		}
+
+		options.Log("running step %q", step)
+
+		if err = r.run(ctx, step); err != nil {
+			return fmt.Errorf("error running step %q: %w", step, err)
+		}
+
+		state.MarkCompleted(step)
+
+		if err = state.Save(options.StatePath); err != nil {
+			return fmt.Errorf("error saving state: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type rotator struct {
+	info    cluster.Info
+	options Options
+	state   *State
+
+	updateNode       func(ctx context.Context, step Step, node string) error
+	updateKubeconfig func(ctx context.Context, nodes []string) error
+}
+
+func (r *rotator) run(ctx context.Context, step Step) error {
+	if step == StepPrepare {
+		return r.prepare(ctx)
+	}
+
+	// control plane nodes go first, as they run trustd which issues certificates to the workers
+	nodes := append(append(r.info.NodesByType(machine.TypeInit), r.info.NodesByType(machine.TypeControlPlane)...), r.info.NodesByType(machine.TypeJoin)...)
+
+	if step == StepTrust {
+		// client should trust the servers with certificates issued by the new CA before any of them switches
+		if err := r.updateTalosConfig(step); err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		if r.state.IsNodeCompleted(step, node) {
+			r.options.Log("%s: step %q is already completed, skipping", node, step)
+
+			continue
+		}
+
+		if err := r.updateNode(ctx, step, node); err != nil {
+			return fmt.Errorf("%s: %w", node, err)
+		}
+
+		r.state.MarkNodeCompleted(step, node)
+
+		if err := r.state.Save(r.options.StatePath); err != nil {
+			return fmt.Errorf("error saving state: %w", err)
+		}
+	}
+
+	if step == StepSwitch || step == StepDrop {
+		if err := r.updateTalosConfig(step); err != nil {
+			return err
+		}
+
+		if err := r.updateKubeconfig(ctx, nodes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *rotator) prepare(ctx context.Context) error {
+	nodes := append(r.info.NodesByType(machine.TypeInit), r.info.NodesByType(machine.TypeControlPlane)...)
+	if len(nodes) == 0 {
+		return fmt.Errorf("no control plane nodes")
+	}
+
+	c, err := r.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer c.Close()
+
+	cfg, err := cluster.ReadConfig(client.WithNodes(ctx, nodes[0]), c)
+	if err != nil {
+		return fmt.Errorf("error reading machine config: %w", err)
+	}
+
+	now := time.Now()
+
+	r.state.RotateTalosCA = r.options.RotateTalosCA
+	r.state.RotateKubernetesCA = r.options.RotateKubernetesCA
+
+	if r.options.RotateTalosCA {
+		r.state.OldTalosCA = cfg.MachineConfig.MachineCA
+
+		ca, err := generate.NewTalosCA(now)
+		if err != nil {
+			return err
+		}
+
+		r.state.NewTalosCA = x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+	}
+
+	if r.options.RotateKubernetesCA {
+		r.state.OldKubernetesCA = cfg.ClusterConfig.ClusterCA
+
+		ca, err := generate.NewKubernetesCA(now)
+		if err != nil {
+			return err
+		}
+
+		r.state.NewKubernetesCA = x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+	}
+
+	return nil
+}
+
+// bundles returns CA bundles for the step: Talos CA, Kubernetes CA.
+func (r *rotator) bundles(step Step) (talosCA, kubernetesCA *x509.PEMEncodedCertificateAndKey) {
+	bundle := func(oldCA, newCA *x509.PEMEncodedCertificateAndKey) *x509.PEMEncodedCertificateAndKey {
+		switch step {
+		case StepTrust:
+			return generate.NewCABundle(oldCA, newCA)
+		case StepSwitch:
+			return generate.NewCABundle(newCA, oldCA)
+		case StepDrop:
+			return generate.NewCABundle(newCA)
+		case StepPrepare:
+			fallthrough
+		default:
+			return oldCA
+		}
+	}
+
+	if r.options.RotateTalosCA {
+		talosCA = bundle(r.state.OldTalosCA, r.state.NewTalosCA)
+	}
+
+	if r.options.RotateKubernetesCA {
+		kubernetesCA = bundle(r.state.OldKubernetesCA, r.state.NewKubernetesCA)
+	}
+
+	return talosCA, kubernetesCA
+}
+
+// patchNode patches node machine config with CA bundles for the step and reboots the node
+// to reissue apid, trustd, kubelet and etcd certificates.
+//
+//nolint: gocyclo
+func (r *rotator) patchNode(ctx context.Context, step Step, node string) error {
+	c, err := r.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer c.Close()
+
+	nodeCtx := client.WithNodes(ctx, node)
+
+	cfg, err := cluster.ReadConfig(nodeCtx, c)
+	if err != nil {
+		return fmt.Errorf("error reading machine config: %w", err)
+	}
+
+	talosCA, kubernetesCA := r.bundles(step)
+
+	changed := false
+
+	// worker nodes don't have Talos CA in the config, they receive it from trustd
+	if talosCA != nil && cfg.MachineConfig.MachineCA != nil {
+		changed = patchCA(&cfg.MachineConfig.MachineCA, talosCA) || changed
+	}
+
+	if kubernetesCA != nil && cfg.ClusterConfig.ClusterCA != nil {
+		changed = patchCA(&cfg.ClusterConfig.ClusterCA, kubernetesCA) || changed
+	}
+
+	bootID, err := cluster.ReadBootID(nodeCtx, c)
+	if err != nil {
+		return fmt.Errorf("error reading boot ID: %w", err)
+	}
+
+	if changed {
+		r.options.Log("%s: applying machine config with updated CAs", node)
+
+		data, err := cfg.Bytes()
+		if err != nil {
+			return err
+		}
+
+		if _, err = c.ApplyConfiguration(nodeCtx, &machineapi.ApplyConfigurationRequest{
+			Data: data,
+		}); err != nil {
+			return fmt.Errorf("error applying machine config: %w", err)
+		}
+	} else {
+		r.options.Log("%s: rebooting to reissue certificates", node)
+
+		if err = c.Reboot(nodeCtx); err != nil {
+			return fmt.Errorf("error rebooting: %w", err)
+		}
+	}
+
+	r.options.Log("%s: waiting for the node to reboot", node)
+
+	// new client is built on every attempt, as the node certificates might be reissued during the reboot
+	return cluster.WaitRebooted(ctx, node, bootID, r.options.NodeTimeout, func(ctx context.Context) (string, error) {
+		c, err := r.client(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		//nolint: errcheck
+		defer c.Close()
+
+		return cluster.ReadBootID(client.WithNodes(ctx, node), c)
+	})
+}
+
+// patchCA replaces CA with the bundle preserving the absence of the key.
+func patchCA(ca **x509.PEMEncodedCertificateAndKey, bundle *x509.PEMEncodedCertificateAndKey) bool {
+	updated := &x509.PEMEncodedCertificateAndKey{
+		Crt: bundle.Crt,
+	}
+
+	if len((*ca).Key) > 0 {
+		updated.Key = bundle.Key
+	}
+
+	if bytes.Equal((*ca).Crt, updated.Crt) && bytes.Equal((*ca).Key, updated.Key) {
+		return false
+	}
+
+	*ca = updated
+
+	return true
+}
+
+func (r *rotator) client(ctx context.Context) (*client.Client, error) {
+	return client.New(ctx, client.WithConfig(r.options.TalosConfig), client.WithContextName(r.options.ContextName))
+}
+
+// updateTalosConfig updates client CA and certificate for the step.
+func (r *rotator) updateTalosConfig(step Step) error {
+	if !r.options.RotateTalosCA {
+		return nil
+	}
+
+	talosCA, _ := r.bundles(step)
+	configContext := r.options.TalosConfig.Contexts[r.options.ContextName]
+
+	configContext.CA = base64.StdEncoding.EncodeToString(talosCA.Crt)
+
+	if step == StepSwitch {
+		admin, err := generate.NewAdminCertificateAndKey(time.Now(), r.state.NewTalosCA.Crt, r.state.NewTalosCA.Key, "127.0.0.1")
+		if err != nil {
+			return fmt.Errorf("error generating admin certificate: %w", err)
+		}
+
+		configContext.Crt = base64.StdEncoding.EncodeToString(admin.Crt)
+		configContext.Key = base64.StdEncoding.EncodeToString(admin.Key)
+	}
+
+	r.options.Log("updating talosconfig %q", r.options.TalosConfigPath)
+
+	return r.options.TalosConfig.Save(r.options.TalosConfigPath)
+}
+
+// fetchKubeconfig fetches admin kubeconfig issued by the new Kubernetes CA.
+func (r *rotator) fetchKubeconfig(ctx context.Context, nodes []string) error {
+	if !r.options.RotateKubernetesCA || r.options.KubeconfigPath == "" || len(nodes) == 0 {
+		return nil
+	}
+
+	c, err := r.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer c.Close()
+
+	kubeconfig, err := c.Kubeconfig(client.WithNodes(ctx, nodes[0]))
+	if err != nil {
+		return fmt.Errorf("error fetching kubeconfig: %w", err)
+	}
+
+	r.options.Log("updating kubeconfig %q", r.options.KubeconfigPath)
+
+	return ioutil.WriteFile(r.options.KubeconfigPath, kubeconfig, 0o600)
+}
@@ -0,0 +1,322 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
+
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+type fakeInfo map[machine.Type][]string
+
+func (info fakeInfo) Nodes() []string {
+	return append(append(info[machine.TypeInit], info[machine.TypeControlPlane]...), info[machine.TypeJoin]...)
+}
+
+func (info fakeInfo) NodesByType(t machine.Type) []string {
+	return info[t]
+}
+
+func newTestCA(t *testing.T, newCA func(time.Time) (*x509.CertificateAuthority, error)) *x509.PEMEncodedCertificateAndKey {
+	ca, err := newCA(time.Now())
+	require.NoError(t, err)
+
+	return x509.NewCertificateAndKeyFromCertificateAuthority(ca)
+}
+
+// certificates returns DER of the certificates in the CA bundle.
+func certificates(bundle []byte) [][]byte {
+	var certs [][]byte
+
+	for _, block := range generate.CABundleCertificates(bundle) {
+		certs = append(certs, block.Bytes)
+	}
+
+	return certs
+}
+
+func TestBundles(t *testing.T) {
+	oldCA := newTestCA(t, generate.NewTalosCA)
+	newCA := newTestCA(t, generate.NewTalosCA)
+
+	r := &rotator{
+		options: Options{
+			RotateTalosCA: true,
+		},
+		state: &State{
+			OldTalosCA: oldCA,
+			NewTalosCA: newCA,
+		},
+	}
+
+	for _, tt := range []struct {
+		step        Step
+		expectedCAs [][]byte
+		expectedKey []byte
+	}{
+		{
+			step:        StepPrepare,
+			expectedCAs: certificates(oldCA.Crt),
+			expectedKey: oldCA.Key,
+		},
+		{
+			step:        StepTrust,
+			expectedCAs: append(certificates(oldCA.Crt), certificates(newCA.Crt)...),
+			expectedKey: oldCA.Key,
+		},
+		{
+			step:        StepSwitch,
+			expectedCAs: append(certificates(newCA.Crt), certificates(oldCA.Crt)...),
+			expectedKey: newCA.Key,
+		},
+		{
+			step:        StepDrop,
+			expectedCAs: certificates(newCA.Crt),
+			expectedKey: newCA.Key,
+		},
+	} {
+		t.Run(string(tt.step), func(t *testing.T) {
+			talosCA, kubernetesCA := r.bundles(tt.step)
+
+			require.NotNil(t, talosCA)
+			assert.Equal(t, tt.expectedCAs, certificates(talosCA.Crt))
+			assert.Equal(t, tt.expectedKey, talosCA.Key)
+
+			// Kubernetes CA is not rotated
+			assert.Nil(t, kubernetesCA)
+		})
+	}
+}
+
+func TestPatchCA(t *testing.T) {
+	bundle := &x509.PEMEncodedCertificateAndKey{
+		Crt: []byte("old\nnew\n"),
+		Key: []byte("old key"),
+	}
+
+	ca := &x509.PEMEncodedCertificateAndKey{
+		Crt: []byte("old\n"),
+		Key: []byte("old key"),
+	}
+
+	assert.True(t, patchCA(&ca, bundle))
+	assert.Equal(t, bundle.Crt, ca.Crt)
+	assert.Equal(t, bundle.Key, ca.Key)
+
+	// already patched
+	assert.False(t, patchCA(&ca, bundle))
+
+	// key is not added to the config which doesn't have it
+	ca = &x509.PEMEncodedCertificateAndKey{
+		Crt: []byte("old\n"),
+	}
+
+	assert.True(t, patchCA(&ca, bundle))
+	assert.Equal(t, bundle.Crt, ca.Crt)
+	assert.Empty(t, ca.Key)
+
+	assert.False(t, patchCA(&ca, bundle))
+}
+
+type update struct {
+	step Step
+	node string
+	ca   string
+}
+
+func newTestRotator(t *testing.T, dir string, updates *[]update, kubeconfigUpdates *int, fail *string) *rotator {
+	oldCA := newTestCA(t, generate.NewTalosCA)
+	newCA := newTestCA(t, generate.NewTalosCA)
+
+	options := Options{
+		TalosConfig: &clientconfig.Config{
+			Context: "test",
+			Contexts: map[string]*clientconfig.Context{
+				"test": {
+					CA: base64.StdEncoding.EncodeToString(oldCA.Crt),
+				},
+			},
+		},
+		TalosConfigPath: filepath.Join(dir, "talosconfig"),
+		ContextName:     "test",
+		StatePath:       filepath.Join(dir, "rotate-ca.yaml"),
+		RotateTalosCA:   true,
+		Log:             t.Logf,
+	}
+
+	r := &rotator{
+		info: fakeInfo{
+			machine.TypeInit:         {"cp1"},
+			machine.TypeControlPlane: {"cp2"},
+			machine.TypeJoin:         {"w1", "w2"},
+		},
+		options: options,
+		state: &State{
+			Completed:     []Step{StepPrepare},
+			RotateTalosCA: true,
+			OldTalosCA:    oldCA,
+			NewTalosCA:    newCA,
+		},
+		updateNode: func(ctx context.Context, step Step, node string) error {
+			if node == *fail {
+				return errors.New("node is down")
+			}
+
+			*updates = append(*updates, update{
+				step: step,
+				node: node,
+				ca:   options.TalosConfig.Contexts["test"].CA,
+			})
+
+			return nil
+		},
+		updateKubeconfig: func(ctx context.Context, nodes []string) error {
+			assert.Equal(t, []string{"cp1", "cp2", "w1", "w2"}, nodes)
+
+			*kubeconfigUpdates++
+
+			return nil
+		},
+	}
+
+	return r
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	var (
+		updates           []update
+		kubeconfigUpdates int
+		fail              string
+	)
+
+	r := newTestRotator(t, dir, &updates, &kubeconfigUpdates, &fail)
+	ctx := context.Background()
+
+	trustCA, _ := r.bundles(StepTrust)
+	switchCA, _ := r.bundles(StepSwitch)
+	dropCA, _ := r.bundles(StepDrop)
+
+	encoded := func(ca *x509.PEMEncodedCertificateAndKey) string {
+		return base64.StdEncoding.EncodeToString(ca.Crt)
+	}
+
+	// client trusts both CAs before any of the nodes is updated, control plane nodes go first
+	require.NoError(t, r.run(ctx, StepTrust))
+
+	assert.Equal(t, []update{
+		{StepTrust, "cp1", encoded(trustCA)},
+		{StepTrust, "cp2", encoded(trustCA)},
+		{StepTrust, "w1", encoded(trustCA)},
+		{StepTrust, "w2", encoded(trustCA)},
+	}, updates)
+	assert.Zero(t, kubeconfigUpdates)
+
+	// failed node stops the step, completed nodes are recorded
+	updates = nil
+	fail = "w1"
+
+	require.EqualError(t, r.run(ctx, StepSwitch), "w1: node is down")
+
+	assert.Equal(t, []update{
+		{StepSwitch, "cp1", encoded(trustCA)},
+		{StepSwitch, "cp2", encoded(trustCA)},
+	}, updates)
+	assert.Zero(t, kubeconfigUpdates)
+
+	state, err := LoadState(r.options.StatePath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cp1", "cp2"}, state.Nodes[StepSwitch])
+
+	// resumed step skips completed nodes, client switches to the new CA once all the nodes are updated
+	updates = nil
+	fail = ""
+
+	require.NoError(t, r.run(ctx, StepSwitch))
+
+	assert.Equal(t, []update{
+		{StepSwitch, "w1", encoded(trustCA)},
+		{StepSwitch, "w2", encoded(trustCA)},
+	}, updates)
+	assert.Equal(t, 1, kubeconfigUpdates)
+
+	cfg, err := clientconfig.Open(r.options.TalosConfigPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, encoded(switchCA), cfg.Contexts["test"].CA)
+
+	crt, err := base64.StdEncoding.DecodeString(cfg.Contexts["test"].Crt)
+	require.NoError(t, err)
+
+	admin, err := (&x509.PEMEncodedCertificateAndKey{Crt: crt}).GetCert()
+	require.NoError(t, err)
+
+	newCA, err := r.state.NewTalosCA.GetCert()
+	require.NoError(t, err)
+
+	// admin certificate is issued by the new CA
+	assert.NoError(t, admin.CheckSignatureFrom(newCA))
+
+	updates = nil
+
+	require.NoError(t, r.run(ctx, StepDrop))
+
+	assert.Len(t, updates, 4)
+	assert.Equal(t, 2, kubeconfigUpdates)
+
+	cfg, err = clientconfig.Open(r.options.TalosConfigPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, encoded(dropCA), cfg.Contexts["test"].CA)
+}
+
+func TestCAResumeMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	statePath := filepath.Join(dir, "rotate-ca.yaml")
+
+	state := &State{
+		RotateKubernetesCA: true,
+	}
+
+	state.MarkCompleted(StepPrepare)
+
+	require.NoError(t, state.Save(statePath))
+
+	err = CA(context.Background(), fakeInfo{}, Options{
+		TalosConfig: &clientconfig.Config{
+			Context: "test",
+			Contexts: map[string]*clientconfig.Context{
+				"test": {},
+			},
+		},
+		StatePath:          statePath,
+		RotateTalosCA:      true,
+		RotateKubernetesCA: true,
+	})
+
+	assert.EqualError(t, err, `state "`+statePath+`": rotation was prepared with talos=false, kubernetes=true, resume it with the same CAs or remove the state to start a new rotation`)
+}
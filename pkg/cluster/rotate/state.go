@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/talos-systems/crypto/x509"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Step is a stage of the CA rotation.
+type Step string
+
+// CA rotation steps, in the order of execution.
+const (
+	// StepPrepare generates new CAs and stores them in the state.
+	StepPrepare Step = "prepare"
+	// StepTrust makes every node trust both old and new CAs, old CA still issues certificates.
+	StepTrust Step = "trust"
+	// StepSwitch makes new CA issue certificates, old CA is still trusted.
+	StepSwitch Step = "switch"
+	// StepDrop removes old CA from the trusted bundle.
+	StepDrop Step = "drop"
+)
+
+// Steps lists all the CA rotation steps in order.
+var Steps = []Step{StepPrepare, StepTrust, StepSwitch, StepDrop}
+
+// StepsUntil returns the steps to run in order to complete the step.
+//
+// If until is empty, all the steps are returned.
+func StepsUntil(until Step) ([]Step, error) {
+	if until == "" {
+		return Steps, nil
+	}
+
+	for i, step := range Steps {
+		if step == until {
+			return Steps[:i+1], nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown step %q, expected one of %v", until, Steps)
+}
+
+// State of the CA rotation.
+//
+// State is saved after every node is processed, so that rotation can be resumed.
+type State struct {
+	Completed []Step            `yaml:"completed"`
+	Nodes     map[Step][]string `yaml:"nodes,omitempty"`
+
+	// CAs being rotated, recorded when the rotation is prepared
+	RotateTalosCA      bool `yaml:"rotateTalosCA"`
+	RotateKubernetesCA bool `yaml:"rotateKubernetesCA"`
+
+	OldTalosCA      *x509.PEMEncodedCertificateAndKey `yaml:"oldTalosCA,omitempty"`
+	NewTalosCA      *x509.PEMEncodedCertificateAndKey `yaml:"newTalosCA,omitempty"`
+	OldKubernetesCA *x509.PEMEncodedCertificateAndKey `yaml:"oldKubernetesCA,omitempty"`
+	NewKubernetesCA *x509.PEMEncodedCertificateAndKey `yaml:"newKubernetesCA,omitempty"`
+}
+
+// LoadState reads the state from the file.
+//
+// If the file doesn't exist, empty state is returned.
+func LoadState(path string) (*State, error) {
+	state := &State{}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+
+		return nil, fmt.Errorf("error reading state: %w", err)
+	}
+
+	if err = yaml.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("error decoding state %q: %w", path, err)
+	}
+
+	return state, nil
+}
+
+// Save writes the state to the file.
+func (state *State) Save(path string) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	// state contains CA keys, so it should be readable only by the owner
+	return ioutil.WriteFile(path, data, 0o600)
+}
+
+// CheckCAs verifies that the rotation is resumed for the same CAs it was prepared for.
+func (state *State) CheckCAs(rotateTalosCA, rotateKubernetesCA bool) error {
+	if !state.IsCompleted(StepPrepare) {
+		return nil
+	}
+
+	if state.RotateTalosCA != rotateTalosCA || state.RotateKubernetesCA != rotateKubernetesCA {
+		return fmt.Errorf("rotation was prepared with talos=%v, kubernetes=%v, resume it with the same CAs or remove the state to start a new rotation",
+			state.RotateTalosCA, state.RotateKubernetesCA)
+	}
+
+	return nil
+}
+
+// IsCompleted checks whether the step was completed.
+func (state *State) IsCompleted(step Step) bool {
+	for _, completed := range state.Completed {
+		if completed == step {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNodeCompleted checks whether the step was completed for the node.
+func (state *State) IsNodeCompleted(step Step, node string) bool {
+	for _, completed := range state.Nodes[step] {
+		if completed == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarkNodeCompleted records that the step was completed for the node.
+func (state *State) MarkNodeCompleted(step Step, node string) {
+	if state.IsNodeCompleted(step, node) {
+		return
+	}
+
+	if state.Nodes == nil {
+		state.Nodes = map[Step][]string{}
+	}
+
+	state.Nodes[step] = append(state.Nodes[step], node)
+}
+
+// MarkCompleted records that the step was completed for the whole cluster.
+func (state *State) MarkCompleted(step Step) {
+	if state.IsCompleted(step) {
+		return
+	}
+
+	state.Completed = append(state.Completed, step)
+	delete(state.Nodes, step)
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotate_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
+
+	"github.com/talos-systems/talos/pkg/cluster/rotate"
+)
+
+func TestStepsUntil(t *testing.T) {
+	for _, tt := range []struct {
+		until    rotate.Step
+		expected []rotate.Step
+	}{
+		{
+			until:    "",
+			expected: []rotate.Step{rotate.StepPrepare, rotate.StepTrust, rotate.StepSwitch, rotate.StepDrop},
+		},
+		{
+			until:    rotate.StepPrepare,
+			expected: []rotate.Step{rotate.StepPrepare},
+		},
+		{
+			until:    rotate.StepSwitch,
+			expected: []rotate.Step{rotate.StepPrepare, rotate.StepTrust, rotate.StepSwitch},
+		},
+	} {
+		steps, err := rotate.StepsUntil(tt.until)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, steps)
+	}
+
+	_, err := rotate.StepsUntil("swtich")
+	assert.EqualError(t, err, `unknown step "swtich", expected one of [prepare trust switch drop]`)
+}
+
+func TestState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	path := filepath.Join(dir, "rotate-ca.yaml")
+
+	state, err := rotate.LoadState(path)
+	require.NoError(t, err)
+	assert.False(t, state.IsCompleted(rotate.StepPrepare))
+
+	state.NewTalosCA = &x509.PEMEncodedCertificateAndKey{Crt: []byte("crt"), Key: []byte("key")}
+	state.MarkCompleted(rotate.StepPrepare)
+	state.MarkNodeCompleted(rotate.StepTrust, "10.5.0.2")
+	state.MarkNodeCompleted(rotate.StepTrust, "10.5.0.2")
+	state.MarkNodeCompleted(rotate.StepTrust, "10.5.0.3")
+
+	require.NoError(t, state.Save(path))
+
+	st, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+
+	state, err = rotate.LoadState(path)
+	require.NoError(t, err)
+
+	assert.True(t, state.IsCompleted(rotate.StepPrepare))
+	assert.False(t, state.IsCompleted(rotate.StepTrust))
+	assert.True(t, state.IsNodeCompleted(rotate.StepTrust, "10.5.0.2"))
+	assert.True(t, state.IsNodeCompleted(rotate.StepTrust, "10.5.0.3"))
+	assert.False(t, state.IsNodeCompleted(rotate.StepTrust, "10.5.0.4"))
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3"}, state.Nodes[rotate.StepTrust])
+	assert.Equal(t, []byte("key"), state.NewTalosCA.Key)
+
+	// completing the step drops per-node progress
+	state.MarkCompleted(rotate.StepTrust)
+	assert.True(t, state.IsCompleted(rotate.StepTrust))
+	assert.False(t, state.IsNodeCompleted(rotate.StepTrust, "10.5.0.2"))
+	assert.Equal(t, []rotate.Step{rotate.StepPrepare, rotate.StepTrust}, state.Completed)
+}
@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package support
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// controlPlaneSelector selects control plane pods which logs are collected.
+const controlPlaneSelector = "k8s-app in (kube-apiserver,kube-scheduler,kube-controller-manager)"
+
+func kubernetesCollectors(cli *client.Client, opts *Options) []collector {
+	k8s := &kubernetesClient{
+		cli:   cli,
+		nodes: opts.Nodes,
+	}
+
+	return []collector{
+		{"kubernetes nodes", 30 * time.Second, func(ctx context.Context, dir *archiveDir) error { return collectK8sNodes(ctx, k8s, dir) }},
+		{"kubernetes pods", time.Minute, func(ctx context.Context, dir *archiveDir) error { return collectK8sPods(ctx, k8s, dir) }},
+		{"control plane logs", 5 * time.Minute, func(ctx context.Context, dir *archiveDir) error { return collectControlPlaneLogs(ctx, k8s, dir, opts) }},
+	}
+}
+
+// kubernetesClient builds Kubernetes client using kubeconfig from the first control plane node
+// among the nodes.
+type kubernetesClient struct {
+	cli   *client.Client
+	nodes []string
+
+	once      sync.Once
+	clientset *kubernetes.Clientset
+	err       error
+}
+
+func (k *kubernetesClient) Clientset(ctx context.Context) (*kubernetes.Clientset, error) {
+	k.once.Do(func() {
+		var kubeconfig []byte
+
+		for _, node := range k.nodes {
+			kubeconfig, k.err = k.cli.Kubeconfig(client.WithNodes(ctx, node))
+			if k.err == nil {
+				break
+			}
+		}
+
+		if k.err != nil {
+			k.err = fmt.Errorf("error fetching kubeconfig: %w", k.err)
+
+			return
+		}
+
+		if kubeconfig == nil {
+			k.err = fmt.Errorf("no nodes to fetch kubeconfig from")
+
+			return
+		}
+
+		config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+		if err != nil {
+			k.err = err
+
+			return
+		}
+
+		k.clientset, k.err = kubernetes.NewForConfig(config)
+	})
+
+	return k.clientset, k.err
+}
+
+func collectK8sNodes(ctx context.Context, k8s *kubernetesClient, dir *archiveDir) error {
+	clientset, err := k8s.Clientset(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tSCHEDULABLE\tVERSION\tINTERNAL-IP\tOS-IMAGE\tKERNEL\tRUNTIME")
+
+	for _, node := range nodes.Items {
+		status := "NotReady"
+
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
+				status = "Ready"
+			}
+		}
+
+		var internalIP string
+
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP {
+				internalIP = addr.Address
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%v\t%s\t%s\t%s\t%s\t%s\n",
+			node.Name, status, !node.Spec.Unschedulable, node.Status.NodeInfo.KubeletVersion, internalIP,
+			node.Status.NodeInfo.OSImage, node.Status.NodeInfo.KernelVersion, node.Status.NodeInfo.ContainerRuntimeVersion)
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	return dir.WriteFile("kubernetes/nodes.txt", buf.Bytes())
+}
+
+func collectK8sPods(ctx context.Context, k8s *kubernetesClient, dir *archiveDir) error {
+	clientset, err := k8s.Clientset(ctx)
+	if err != nil {
+		return err
+	}
+
+	pods, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tREADY\tSTATUS\tRESTARTS\tNODE\tREASON")
+
+	for _, pod := range pods.Items {
+		var (
+			ready    int
+			restarts int32
+			reasons  []string
+		)
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+
+			restarts += status.RestartCount
+
+			if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+				reasons = append(reasons, status.State.Waiting.Reason)
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d/%d\t%s\t%d\t%s\t%s\n",
+			pod.Namespace, pod.Name, ready, len(pod.Spec.Containers), pod.Status.Phase, restarts, pod.Spec.NodeName, strings.Join(reasons, ","))
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	return dir.WriteFile("kubernetes/pods.txt", buf.Bytes())
+}
+
+func collectControlPlaneLogs(ctx context.Context, k8s *kubernetesClient, dir *archiveDir, opts *Options) error {
+	clientset, err := k8s.Clientset(ctx)
+	if err != nil {
+		return err
+	}
+
+	pods, err := clientset.CoreV1().Pods(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{
+		LabelSelector: controlPlaneSelector,
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			logOpts := &corev1.PodLogOptions{
+				Container: container.Name,
+			}
+
+			if opts.LogLines >= 0 {
+				tailLines := int64(opts.LogLines)
+				logOpts.TailLines = &tailLines
+			}
+
+			if logErr := collectPodLogs(ctx, clientset, dir, pod.Namespace, pod.Name, logOpts); logErr != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error getting logs of %s/%s:%s: %w", pod.Namespace, pod.Name, container.Name, logErr))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func collectPodLogs(ctx context.Context, clientset *kubernetes.Clientset, dir *archiveDir, namespace, pod string, logOpts *corev1.PodLogOptions) error {
+	stream, err := clientset.CoreV1().Pods(namespace).GetLogs(pod, logOpts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer stream.Close()
+
+	data, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return err
+	}
+
+	return dir.WriteFile(fmt.Sprintf("kubernetes/logs/%s/%s/%s.log", namespace, pod, logOpts.Container), data)
+}
@@ -0,0 +1,397 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package support
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/talos-systems/os-runtime/pkg/resource"
+	"github.com/talos-systems/os-runtime/pkg/resource/core"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// secretsNamespace is not dumped to the support bundle.
+const secretsNamespace = "secrets"
+
+// machineConfigType is the type of the resource which holds the machine configuration.
+const machineConfigType = "config/v1alpha1"
+
+func nodeCollectors(cli *client.Client, opts *Options) []collector {
+	return []collector{
+		{"services", 5 * time.Minute, func(ctx context.Context, dir *archiveDir) error { return collectServices(ctx, cli, dir, opts) }},
+		{"dmesg", time.Minute, func(ctx context.Context, dir *archiveDir) error { return collectDmesg(ctx, cli, dir) }},
+		{"events", time.Minute, func(ctx context.Context, dir *archiveDir) error { return collectEvents(ctx, cli, dir) }},
+		{"mounts", 30 * time.Second, func(ctx context.Context, dir *archiveDir) error { return collectMounts(ctx, cli, dir) }},
+		{"processes", 30 * time.Second, func(ctx context.Context, dir *archiveDir) error { return collectProcesses(ctx, cli, dir) }},
+		{"resources", 2 * time.Minute, func(ctx context.Context, dir *archiveDir) error { return collectResources(ctx, cli, dir) }},
+		{"machine config", 30 * time.Second, func(ctx context.Context, dir *archiveDir) error { return collectMachineConfig(ctx, cli, dir) }},
+	}
+}
+
+// collectServices writes the service list and logs of each service.
+func collectServices(ctx context.Context, cli *client.Client, dir *archiveDir, opts *Options) error {
+	resp, err := cli.ServiceList(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		buf  bytes.Buffer
+		errs *multierror.Error
+	)
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "SERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
+
+	for _, msg := range resp.Messages {
+		for _, svc := range msg.Services {
+			health := "?"
+
+			if !svc.GetHealth().GetUnknown() {
+				if svc.GetHealth().GetHealthy() {
+					health = "OK"
+				} else {
+					health = "Fail"
+				}
+			}
+
+			var lastEvent string
+
+			if events := svc.GetEvents().GetEvents(); len(events) > 0 {
+				lastEvent = events[len(events)-1].Msg
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", svc.Id, svc.State, health, svc.GetHealth().GetLastChange().AsTime().Format(time.RFC3339), lastEvent)
+
+			if logErr := collectServiceLogs(ctx, cli, dir, svc.Id, opts.LogLines); logErr != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error getting logs of %q: %w", svc.Id, logErr))
+			}
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if err = dir.WriteFile("services.txt", buf.Bytes()); err != nil {
+		return err
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func collectServiceLogs(ctx context.Context, cli *client.Client, dir *archiveDir, id string, logLines int32) error {
+	stream, err := cli.Logs(ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD, id, false, logLines)
+	if err != nil {
+		return err
+	}
+
+	data, err := readStream(stream)
+	if err != nil {
+		return err
+	}
+
+	return dir.WriteFile("logs/"+id+".log", data)
+}
+
+func collectDmesg(ctx context.Context, cli *client.Client, dir *archiveDir) error {
+	stream, err := cli.Dmesg(ctx, false, false)
+	if err != nil {
+		return err
+	}
+
+	data, err := readStream(client.DmesgDataStream(stream))
+	if err != nil {
+		return err
+	}
+
+	return dir.WriteFile("dmesg.log", data)
+}
+
+// collectEvents writes all the events of the current boot as JSON lines.
+func collectEvents(ctx context.Context, cli *client.Client, dir *archiveDir) error {
+	stream, err := cli.Events(ctx, client.WithTailEvents(-1), client.WithTimeRange(time.Time{}, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	for {
+		event, recvErr := stream.Recv()
+		if recvErr != nil {
+			if recvErr == io.EOF {
+				break
+			}
+
+			return recvErr
+		}
+
+		if event.GetMetadata().GetError() != "" {
+			return errors.New(event.GetMetadata().GetError())
+		}
+
+		// metadata duplicates the node name which is already known
+		event.Metadata = nil
+
+		line, marshalErr := protojson.Marshal(event)
+		if marshalErr != nil {
+			return marshalErr
+		}
+
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	return dir.WriteFile("events.log", buf.Bytes())
+}
+
+func collectMounts(ctx context.Context, cli *client.Client, dir *archiveDir) error {
+	resp, err := cli.Mounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "FILESYSTEM\tSIZE\tAVAILABLE\tMOUNTED ON")
+
+	for _, msg := range resp.Messages {
+		for _, stat := range msg.Stats {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", stat.Filesystem, stat.Size, stat.Available, stat.MountedOn)
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	return dir.WriteFile("mounts.txt", buf.Bytes())
+}
+
+func collectProcesses(ctx context.Context, cli *client.Client, dir *archiveDir) error {
+	resp, err := cli.Processes(ctx)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "PID\tPPID\tSTATE\tTHREADS\tCPU-TIME\tVIRTMEM\tRESMEM\tCOMMAND")
+
+	for _, msg := range resp.Messages {
+		for _, p := range msg.Processes {
+			command := p.Executable
+			if p.Args != "" {
+				command = p.Args
+			}
+
+			fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%.2f\t%d\t%d\t%s\n", p.Pid, p.Ppid, p.State, p.Threads, p.CpuTime, p.VirtualMemory, p.ResidentMemory, command)
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	return dir.WriteFile("processes.txt", buf.Bytes())
+}
+
+// collectResources dumps all the resources of every registered resource type except for secrets,
+// secrets are redacted from the machine configuration.
+//
+//nolint: gocyclo
+func collectResources(ctx context.Context, cli *client.Client, dir *archiveDir) error {
+	definitions, err := listResources(ctx, cli, core.NamespaceName, core.ResourceDefinitionType)
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, definition := range definitions {
+		var spec core.ResourceDefinitionSpec
+
+		if err = decodeSpec(definition, &spec); err != nil {
+			return err
+		}
+
+		if spec.DefaultNamespace == secretsNamespace {
+			continue
+		}
+
+		resources, listErr := listResources(ctx, cli, spec.DefaultNamespace, definition.Metadata().ID())
+		if listErr != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing %q: %w", definition.Metadata().ID(), listErr))
+
+			continue
+		}
+
+		var buf bytes.Buffer
+
+		enc := yaml.NewEncoder(&buf)
+
+		for _, r := range resources {
+			out, marshalErr := marshalResource(r)
+			if marshalErr != nil {
+				return marshalErr
+			}
+
+			if err = enc.Encode(out); err != nil {
+				return err
+			}
+		}
+
+		if err = enc.Close(); err != nil {
+			return err
+		}
+
+		if err = dir.WriteFile("resources/"+definition.Metadata().ID()+".yaml", buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// marshalResource prepares the resource to be dumped as YAML with the secrets redacted from the machine configuration.
+func marshalResource(r resource.Resource) (interface{}, error) {
+	if r.Metadata().Type() != machineConfigType {
+		return resource.MarshalYAML(r)
+	}
+
+	data, err := yaml.Marshal(r.Spec())
+	if err != nil {
+		return nil, err
+	}
+
+	redacted, err := redactConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec yaml.Node
+
+	if err = yaml.Unmarshal(redacted, &spec); err != nil {
+		return nil, err
+	}
+
+	return &struct {
+		Metadata *resource.Metadata `yaml:"metadata"`
+		Spec     *yaml.Node         `yaml:"spec"`
+	}{
+		Metadata: r.Metadata(),
+		Spec:     spec.Content[0],
+	}, nil
+}
+
+func listResources(ctx context.Context, cli *client.Client, namespace, resourceType string) ([]resource.Resource, error) {
+	listClient, err := cli.Resources.List(ctx, namespace, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []resource.Resource
+
+	for {
+		msg, recvErr := listClient.Recv()
+		if recvErr != nil {
+			if recvErr == io.EOF {
+				return resources, nil
+			}
+
+			return nil, recvErr
+		}
+
+		if msg.Metadata.GetError() != "" {
+			return nil, errors.New(msg.Metadata.GetError())
+		}
+
+		if msg.Resource != nil {
+			resources = append(resources, msg.Resource)
+		}
+	}
+}
+
+func decodeSpec(r resource.Resource, spec interface{}) error {
+	out, err := yaml.Marshal(r.Spec())
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(out, spec)
+}
+
+// collectMachineConfig writes the machine configuration with the secrets redacted.
+func collectMachineConfig(ctx context.Context, cli *client.Client, dir *archiveDir) error {
+	r, errCh, err := cli.Read(ctx, constants.ConfigPath)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if err = <-errCh; err != nil {
+		return err
+	}
+
+	redacted, err := redactConfig(data)
+	if err != nil {
+		return err
+	}
+
+	return dir.WriteFile("machine-config.yaml", redacted)
+}
+
+func redactConfig(data []byte) ([]byte, error) {
+	cfg, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Redact(config.RedactedValue)
+
+	return cfg.Bytes()
+}
+
+func readStream(stream client.MachineStream) ([]byte, error) {
+	r, errCh, err := client.ReadStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, <-errCh
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint: testpackage
+package support
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/os-runtime/pkg/resource"
+	"gopkg.in/yaml.v3"
+
+	resourceapi "github.com/talos-systems/talos/pkg/machinery/api/resource"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+const testMachineConfig = `version: v1alpha1
+machine:
+  type: join
+  token: machinetoken.secret
+  ca:
+    crt: Y2VydA==
+    key: bWFjaGluZWtleQ==
+cluster:
+  controlPlane:
+    endpoint: https://10.5.0.2:6443
+  token: clustertoken.secret
+  aescbcEncryptionSecret: encryptionsecret
+`
+
+func marshalTestResource(t *testing.T, resourceType string, spec []byte) string {
+	r, err := resource.NewAnyFromProto(&resourceapi.Metadata{
+		Namespace: "config",
+		Type:      resourceType,
+		Id:        "v1alpha1",
+		Version:   "1",
+		Phase:     "running",
+	}, &resourceapi.Spec{
+		Yaml: spec,
+	})
+	require.NoError(t, err)
+
+	out, err := marshalResource(r)
+	require.NoError(t, err)
+
+	data, err := yaml.Marshal(out)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestMarshalResourceRedactsMachineConfig(t *testing.T) {
+	data := marshalTestResource(t, machineConfigType, []byte(testMachineConfig))
+
+	for _, secret := range []string{"machinetoken.secret", "bWFjaGluZWtleQ==", "clustertoken.secret", "encryptionsecret"} {
+		assert.NotContains(t, data, secret)
+	}
+
+	assert.Contains(t, data, config.RedactedValue)
+	assert.Contains(t, data, "https://10.5.0.2:6443")
+	assert.Contains(t, data, "type: config/v1alpha1")
+}
+
+func TestMarshalResourceOther(t *testing.T) {
+	data := marshalTestResource(t, "config/machineType", []byte("machineType: join\n"))
+
+	assert.Contains(t, data, "machineType: join")
+	assert.Contains(t, data, "type: config/machineType")
+}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package support collects support bundle: logs, state and configuration of the Talos nodes
+// and of the Kubernetes cluster, packed into a single archive.
+package support
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// Options configures support bundle collection.
+type Options struct {
+	// Nodes to collect the information from.
+	Nodes []string
+	// Progress receives progress messages, might be nil.
+	Progress io.Writer
+	// NumWorkers limits number of nodes processed concurrently.
+	NumWorkers int
+	// LogLines limits number of lines collected from each log, -1 collects all the lines.
+	LogLines int32
+}
+
+// DefaultOptions returns default support bundle options.
+func DefaultOptions() *Options {
+	return &Options{
+		NumWorkers: 5,
+		LogLines:   -1,
+	}
+}
+
+// ClusterDir is the archive directory for the cluster-wide (Kubernetes) information.
+const ClusterDir = "cluster"
+
+// ErrorsFile is the name of the file in each archive directory with errors encountered during collection.
+const ErrorsFile = "errors.txt"
+
+// collector gathers a piece of information and writes it into the archive directory.
+//
+// Collector is canceled once the timeout expires, as the time to collect the information
+// varies a lot between the collectors (e.g. process list vs. logs of all the services).
+type collector struct {
+	name    string
+	timeout time.Duration
+	collect func(ctx context.Context, dir *archiveDir) error
+}
+
+// Collect gathers the support bundle and writes it as a zip archive to out.
+//
+// Failure to collect some piece of information doesn't abort the collection,
+// errors are written to the `errors.txt` file of the node (or cluster) directory instead.
+func Collect(ctx context.Context, cli *client.Client, out io.Writer, opts *Options) error {
+	arch := &archive{
+		w: zip.NewWriter(out),
+	}
+
+	progress := &progress{
+		w: opts.Progress,
+	}
+
+	numWorkers := opts.NumWorkers
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+
+	sem := make(chan struct{}, numWorkers)
+
+	var wg sync.WaitGroup
+
+	for _, node := range opts.Nodes {
+		node := node
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			run(client.WithNodes(ctx, node), arch.Dir(node), progress, nodeCollectors(cli, opts))
+		}()
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		run(ctx, arch.Dir(ClusterDir), progress, kubernetesCollectors(cli, opts))
+	}()
+
+	wg.Wait()
+
+	return arch.Close()
+}
+
+func run(ctx context.Context, dir *archiveDir, progress *progress, collectors []collector) {
+	var errs *multierror.Error
+
+	for i, c := range collectors {
+		err := func() error {
+			collectCtx, collectCancel := context.WithTimeout(ctx, c.timeout)
+			defer collectCancel()
+
+			return c.collect(collectCtx, dir)
+		}()
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", c.name, err))
+
+			progress.Printf("%s: [%d/%d] %s: error: %s", dir.prefix, i+1, len(collectors), c.name, err)
+
+			continue
+		}
+
+		progress.Printf("%s: [%d/%d] %s", dir.prefix, i+1, len(collectors), c.name)
+	}
+
+	if errs != nil {
+		if err := dir.WriteFile(ErrorsFile, []byte(errs.Error())); err != nil {
+			progress.Printf("%s: error writing errors: %s", dir.prefix, err)
+		}
+	}
+}
+
+type progress struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (p *progress) Printf(format string, args ...interface{}) {
+	if p.w == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fmt.Fprintf(p.w, format+"\n", args...)
+}
+
+// archive serializes writes of the files collected concurrently.
+type archive struct {
+	mu sync.Mutex
+	w  *zip.Writer
+}
+
+func (a *archive) Dir(prefix string) *archiveDir {
+	return &archiveDir{
+		archive: a,
+		prefix:  prefix,
+	}
+}
+
+func (a *archive) writeFile(name string, data []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	w, err := a.w.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+
+	return err
+}
+
+func (a *archive) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.w.Close()
+}
+
+type archiveDir struct {
+	*archive
+
+	prefix string
+}
+
+// WriteFile writes file to the archive relative to the directory.
+func (d *archiveDir) WriteFile(name string, data []byte) error {
+	return d.archive.writeFile(path.Join(d.prefix, sanitize(name)), data)
+}
+
+// sanitize makes sure archive path doesn't escape the directory.
+func sanitize(name string) string {
+	parts := strings.Split(name, "/")
+
+	for i := range parts {
+		if parts[i] == ".." || parts[i] == "." {
+			parts[i] = "_"
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgrade
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// State of the rolling upgrade.
+//
+// State is saved after every node is processed, so that upgrade can be paused and resumed.
+type State struct {
+	Image string `yaml:"image"`
+	// InProgress maps nodes being upgraded to their boot ID before the upgrade.
+	InProgress map[string]string `yaml:"inProgress,omitempty"`
+	Upgraded   []string          `yaml:"upgraded,omitempty"`
+}
+
+// LoadState reads the state from the file.
+//
+// If the file doesn't exist, empty state is returned.
+func LoadState(path string) (*State, error) {
+	state := &State{}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+
+		return nil, fmt.Errorf("error reading state: %w", err)
+	}
+
+	if err = yaml.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("error decoding state %q: %w", path, err)
+	}
+
+	return state, nil
+}
+
+// Save writes the state to the file.
+func (state *State) Save(path string) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0o600)
+}
+
+// IsUpgraded checks whether the node was upgraded.
+func (state *State) IsUpgraded(node string) bool {
+	for _, upgraded := range state.Upgraded {
+		if upgraded == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarkInProgress records that the upgrade was requested for the node.
+func (state *State) MarkInProgress(node, bootID string) {
+	if state.InProgress == nil {
+		state.InProgress = map[string]string{}
+	}
+
+	state.InProgress[node] = bootID
+}
+
+// MarkUpgraded records that the node was upgraded.
+func (state *State) MarkUpgraded(node string) {
+	delete(state.InProgress, node)
+
+	if state.IsUpgraded(node) {
+		return
+	}
+
+	state.Upgraded = append(state.Upgraded, node)
+}
+
+// MarkReverted records that the node reverted the upgrade.
+func (state *State) MarkReverted(node string) {
+	delete(state.InProgress, node)
+}
@@ -0,0 +1,280 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package upgrade implements rolling upgrade of Talos across the cluster.
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/cluster/check"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// Options configures rolling upgrade.
+type Options struct {
+	// Image is the installer image to upgrade to.
+	Image    string
+	Preserve bool
+	Stage    bool
+
+	// WorkerBatchSize is the number of worker nodes upgraded at once.
+	//
+	// Control plane nodes are always upgraded one at a time to keep etcd quorum.
+	WorkerBatchSize int
+
+	// StatePath is the path to the state file used to resume the upgrade.
+	StatePath string
+
+	// NodeTimeout is the timeout for a single node to reboot into the new version.
+	NodeTimeout time.Duration
+	// CheckTimeout is the timeout for the cluster to become healthy after each step.
+	CheckTimeout time.Duration
+
+	Reporter check.Reporter
+	Log      func(format string, args ...interface{})
+}
+
+// Cluster performs rolling upgrade of the cluster nodes.
+//
+// Control plane nodes are upgraded one by one, followed by worker nodes in batches.
+// Cluster health is checked before the upgrade and after every step.
+// Node is upgraded once it reboots into the Talos version of the installer image tag,
+// the upgrade stops if the node reverts to the previous version.
+// Progress is saved to the state file, so the upgrade can be interrupted and resumed.
+func Cluster(ctx context.Context, clusterInfo check.ClusterInfo, options Options) error {
+	if options.Image == "" {
+		return fmt.Errorf("image is required")
+	}
+
+	version, err := targetVersion(options.Image)
+	if err != nil {
+		return err
+	}
+
+	if options.WorkerBatchSize < 1 {
+		return fmt.Errorf("worker batch size should be positive: %d", options.WorkerBatchSize)
+	}
+
+	if options.Log == nil {
+		options.Log = func(string, ...interface{}) {}
+	}
+
+	state, err := LoadState(options.StatePath)
+	if err != nil {
+		return err
+	}
+
+	if state.Image != "" && state.Image != options.Image {
+		return fmt.Errorf("state %q records upgrade to %q, remove it to start a new upgrade", options.StatePath, state.Image)
+	}
+
+	state.Image = options.Image
+
+	c, err := clusterInfo.Client()
+	if err != nil {
+		return err
+	}
+
+	u := &upgrader{
+		api:     &talosAPI{c: c},
+		options: options,
+		version: version,
+		state:   state,
+		checkHealth: func(ctx context.Context) error {
+			checkCtx, checkCtxCancel := context.WithTimeout(ctx, options.CheckTimeout)
+			defer checkCtxCancel()
+
+			if err := check.Wait(checkCtx, clusterInfo, check.DefaultClusterChecks(), options.Reporter); err != nil {
+				return fmt.Errorf("cluster is not healthy: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	controlPlaneNodes := append(clusterInfo.NodesByType(machine.TypeInit), clusterInfo.NodesByType(machine.TypeControlPlane)...)
+	workerNodes := clusterInfo.NodesByType(machine.TypeJoin)
+
+	batches := make([][]string, 0, len(controlPlaneNodes)+len(workerNodes))
+
+	for _, node := range controlPlaneNodes {
+		batches = append(batches, []string{node})
+	}
+
+	for i := 0; i < len(workerNodes); i += options.WorkerBatchSize {
+		end := i + options.WorkerBatchSize
+		if end > len(workerNodes) {
+			end = len(workerNodes)
+		}
+
+		batches = append(batches, workerNodes[i:end])
+	}
+
+	return u.run(ctx, batches)
+}
+
+// targetVersion returns the Talos version the installer image upgrades to.
+func targetVersion(image string) (string, error) {
+	name := image[strings.LastIndex(image, "/")+1:]
+
+	idx := strings.LastIndex(name, ":")
+	if idx == -1 || strings.Contains(name, "@") {
+		return "", fmt.Errorf("image %q should be tagged with the Talos version", image)
+	}
+
+	return name[idx+1:], nil
+}
+
+// nodeAPI is the subset of the Talos API used by the upgrade.
+type nodeAPI interface {
+	BootID(ctx context.Context, node string) (string, error)
+	Version(ctx context.Context, node string) (string, error)
+	Upgrade(ctx context.Context, node, image string, preserve, stage bool) error
+}
+
+type talosAPI struct {
+	c *client.Client
+}
+
+func (api *talosAPI) BootID(ctx context.Context, node string) (string, error) {
+	return cluster.ReadBootID(client.WithNodes(ctx, node), api.c)
+}
+
+func (api *talosAPI) Version(ctx context.Context, node string) (string, error) {
+	resp, err := api.c.Version(client.WithNodes(ctx, node))
+	if err != nil {
+		return "", err
+	}
+
+	if len(resp.GetMessages()) != 1 {
+		return "", fmt.Errorf("unexpected number of responses: %d", len(resp.GetMessages()))
+	}
+
+	return resp.GetMessages()[0].GetVersion().GetTag(), nil
+}
+
+func (api *talosAPI) Upgrade(ctx context.Context, node, image string, preserve, stage bool) error {
+	_, err := api.c.Upgrade(client.WithNodes(ctx, node), image, preserve, stage)
+
+	return err
+}
+
+type upgrader struct {
+	api         nodeAPI
+	checkHealth func(ctx context.Context) error
+
+	options Options
+	version string
+	state   *State
+}
+
+func (u *upgrader) run(ctx context.Context, batches [][]string) error {
+	u.options.Log("checking cluster health before the upgrade")
+
+	if err := u.checkHealth(ctx); err != nil {
+		return err
+	}
+
+	for _, batch := range batches {
+		var pending []string
+
+		for _, node := range batch {
+			if u.state.IsUpgraded(node) {
+				u.options.Log("%s: already upgraded, skipping", node)
+
+				continue
+			}
+
+			pending = append(pending, node)
+		}
+
+		if len(pending) == 0 {
+			continue
+		}
+
+		if err := u.upgradeNodes(ctx, pending); err != nil {
+			return err
+		}
+
+		u.options.Log("checking cluster health after upgrading %v", pending)
+
+		if err := u.checkHealth(ctx); err != nil {
+			return err
+		}
+	}
+
+	u.options.Log("all nodes are upgraded to %q", u.options.Image)
+
+	return nil
+}
+
+// upgradeNodes requests the upgrade of every node and waits for all of them to reboot into the new version.
+func (u *upgrader) upgradeNodes(ctx context.Context, nodes []string) error {
+	for _, node := range nodes {
+		if _, ok := u.state.InProgress[node]; ok {
+			// upgrade was requested before the upgrade was interrupted
+			continue
+		}
+
+		bootID, err := u.api.BootID(ctx, node)
+		if err != nil {
+			return fmt.Errorf("%s: error reading boot ID: %w", node, err)
+		}
+
+		u.options.Log("%s: upgrading to %q", node, u.options.Image)
+
+		if err = u.api.Upgrade(ctx, node, u.options.Image, u.options.Preserve, u.options.Stage); err != nil {
+			return fmt.Errorf("%s: error upgrading: %w", node, err)
+		}
+
+		u.state.MarkInProgress(node, bootID)
+
+		if err = u.state.Save(u.options.StatePath); err != nil {
+			return fmt.Errorf("error saving state: %w", err)
+		}
+	}
+
+	for _, node := range nodes {
+		u.options.Log("%s: waiting for the node to reboot", node)
+
+		node := node
+
+		if err := cluster.WaitRebooted(ctx, node, u.state.InProgress[node], u.options.NodeTimeout, func(ctx context.Context) (string, error) {
+			return u.api.BootID(ctx, node)
+		}); err != nil {
+			return fmt.Errorf("%s: %w", node, err)
+		}
+
+		// node might have reverted to the previous version if the upgraded one didn't become healthy
+		version, err := u.api.Version(ctx, node)
+		if err != nil {
+			return fmt.Errorf("%s: error reading version: %w", node, err)
+		}
+
+		if version != u.version {
+			// upgrade is requested again when the upgrade is resumed
+			u.state.MarkReverted(node)
+
+			if err = u.state.Save(u.options.StatePath); err != nil {
+				return fmt.Errorf("error saving state: %w", err)
+			}
+
+			return fmt.Errorf("%s: node is running version %q after the reboot instead of %q, upgrade was reverted", node, version, u.version)
+		}
+
+		u.state.MarkUpgraded(node)
+
+		if err = u.state.Save(u.options.StatePath); err != nil {
+			return fmt.Errorf("error saving state: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNode struct {
+	bootID  int
+	version string
+	revert  bool
+}
+
+// fakeAPI reboots the node immediately on upgrade.
+type fakeAPI struct {
+	mu sync.Mutex
+
+	target   string
+	nodes    map[string]*fakeNode
+	upgrades []string
+}
+
+func newFakeAPI(target string, nodes ...string) *fakeAPI {
+	api := &fakeAPI{
+		target: target,
+		nodes:  map[string]*fakeNode{},
+	}
+
+	for _, node := range nodes {
+		api.nodes[node] = &fakeNode{version: "v0.8.0"}
+	}
+
+	return api
+}
+
+func (api *fakeAPI) BootID(ctx context.Context, node string) (string, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return fmt.Sprintf("boot-%d", api.nodes[node].bootID), nil
+}
+
+func (api *fakeAPI) Version(ctx context.Context, node string) (string, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return api.nodes[node].version, nil
+}
+
+func (api *fakeAPI) Upgrade(ctx context.Context, node, image string, preserve, stage bool) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.upgrades = append(api.upgrades, node)
+
+	n := api.nodes[node]
+	n.bootID++
+
+	if !n.revert {
+		n.version = api.target
+	}
+
+	return nil
+}
+
+func newTestUpgrader(t *testing.T, api nodeAPI, statePath string, healthChecks *int) *upgrader {
+	state, err := LoadState(statePath)
+	require.NoError(t, err)
+
+	return &upgrader{
+		api: api,
+		checkHealth: func(ctx context.Context) error {
+			*healthChecks++
+
+			return nil
+		},
+		options: Options{
+			Image:       "ghcr.io/talos-systems/installer:v0.9.0",
+			StatePath:   statePath,
+			NodeTimeout: time.Minute,
+			Log:         func(string, ...interface{}) {},
+		},
+		version: "v0.9.0",
+		state:   state,
+	}
+}
+
+func tempStatePath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "upgrade")
+	require.NoError(t, err)
+
+	return filepath.Join(dir, "upgrade.yaml"), func() { os.RemoveAll(dir) } //nolint: errcheck
+}
+
+func TestUpgradeOrder(t *testing.T) {
+	statePath, cleanup := tempStatePath(t)
+	defer cleanup()
+
+	api := newFakeAPI("v0.9.0", "cp1", "cp2", "w1", "w2", "w3")
+
+	var healthChecks int
+
+	u := newTestUpgrader(t, api, statePath, &healthChecks)
+
+	require.NoError(t, u.run(context.Background(), [][]string{{"cp1"}, {"cp2"}, {"w1", "w2"}, {"w3"}}))
+
+	assert.Equal(t, []string{"cp1", "cp2", "w1", "w2", "w3"}, api.upgrades)
+	assert.Equal(t, 5, healthChecks)
+
+	state, err := LoadState(statePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"cp1", "cp2", "w1", "w2", "w3"}, state.Upgraded)
+	assert.Empty(t, state.InProgress)
+}
+
+func TestUpgradeRevert(t *testing.T) {
+	statePath, cleanup := tempStatePath(t)
+	defer cleanup()
+
+	api := newFakeAPI("v0.9.0", "cp1", "w1", "w2")
+	api.nodes["w1"].revert = true
+
+	var healthChecks int
+
+	u := newTestUpgrader(t, api, statePath, &healthChecks)
+
+	err := u.run(context.Background(), [][]string{{"cp1"}, {"w1", "w2"}})
+	assert.EqualError(t, err, `w1: node is running version "v0.8.0" after the reboot instead of "v0.9.0", upgrade was reverted`)
+
+	state, err := LoadState(statePath)
+	require.NoError(t, err)
+
+	// node which reverted the upgrade is neither upgraded nor in progress
+	assert.Equal(t, []string{"cp1"}, state.Upgraded)
+	assert.Equal(t, map[string]string{"w2": "boot-0"}, state.InProgress)
+
+	// resume once the node is fixed: upgrade is requested again for the reverted node only
+	api.nodes["w1"].revert = false
+	api.upgrades = nil
+
+	u = newTestUpgrader(t, api, statePath, &healthChecks)
+
+	require.NoError(t, u.run(context.Background(), [][]string{{"cp1"}, {"w1", "w2"}}))
+
+	assert.Equal(t, []string{"w1"}, api.upgrades)
+
+	state, err = LoadState(statePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"cp1", "w1", "w2"}, state.Upgraded)
+	assert.Empty(t, state.InProgress)
+}
+
+func TestUpgradeResumeInProgress(t *testing.T) {
+	statePath, cleanup := tempStatePath(t)
+	defer cleanup()
+
+	api := newFakeAPI("v0.9.0", "cp1", "cp2")
+
+	// upgrade of cp1 was requested, and it rebooted while the upgrade was interrupted
+	api.nodes["cp1"].bootID = 1
+	api.nodes["cp1"].version = "v0.9.0"
+
+	state := &State{
+		Image:      "ghcr.io/talos-systems/installer:v0.9.0",
+		InProgress: map[string]string{"cp1": "boot-0"},
+	}
+	require.NoError(t, state.Save(statePath))
+
+	var healthChecks int
+
+	u := newTestUpgrader(t, api, statePath, &healthChecks)
+
+	require.NoError(t, u.run(context.Background(), [][]string{{"cp1"}, {"cp2"}}))
+
+	assert.Equal(t, []string{"cp2"}, api.upgrades)
+	assert.Equal(t, []string{"cp1", "cp2"}, u.state.Upgraded)
+}
+
+func TestTargetVersion(t *testing.T) {
+	for _, tt := range []struct {
+		image    string
+		expected string
+	}{
+		{"ghcr.io/talos-systems/installer:v0.9.0", "v0.9.0"},
+		{"localhost:5000/installer:v0.9.0-alpha.1", "v0.9.0-alpha.1"},
+	} {
+		version, err := targetVersion(tt.image)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, version)
+	}
+
+	for _, image := range []string{
+		"localhost:5000/installer",
+		"ghcr.io/talos-systems/installer@sha256:0123456789abcdef",
+	} {
+		_, err := targetVersion(image)
+		assert.Error(t, err, image)
+	}
+}
+
+func TestStateSave(t *testing.T) {
+	statePath, cleanup := tempStatePath(t)
+	defer cleanup()
+
+	state := &State{Image: "ghcr.io/talos-systems/installer:v0.9.0"}
+	state.MarkInProgress("w1", "boot-0")
+
+	require.NoError(t, state.Save(statePath))
+
+	st, err := os.Stat(statePath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+}
@@ -7,6 +7,7 @@ package follow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -16,6 +17,9 @@ import (
 	"gopkg.in/fsnotify.v1"
 )
 
+// ErrFileRemoved is returned when the file is removed or renamed while following it.
+var ErrFileRemoved = errors.New("file was removed while watching")
+
 // Reader implements io.ReadCloser over regular file following file contents.
 //
 // This makes file similar to the stream in semantics.
@@ -159,9 +163,9 @@ func (r *Reader) notify() {
 				case r.notifyCh <- nil:
 				default:
 				}
-			case fsnotify.Remove:
+			case fsnotify.Remove, fsnotify.Rename:
 				select {
-				case r.notifyCh <- fmt.Errorf("file was removed while watching"):
+				case r.notifyCh <- ErrFileRemoved:
 				case <-r.ctx.Done():
 				}
 
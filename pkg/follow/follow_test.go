@@ -228,6 +228,24 @@ func (suite *FollowSuite) TestDeleted() {
 	suite.Require().Equal([]byte("abcdefghijklmno"), <-combinedCh)
 }
 
+func (suite *FollowSuite) TestRenamed() {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	combinedCh := suite.readAll(ctx, "file was removed while watching", 7, time.Second)
+
+	// nolint: errcheck
+	suite.writer.WriteString("abc")
+	// nolint: errcheck
+	suite.writer.WriteString("def")
+	time.Sleep(150 * time.Millisecond)
+
+	// chunker should terminate when file is renamed (e.g. rotated)
+	suite.Require().NoError(os.Rename(suite.writer.Name(), suite.writer.Name()+".1"))
+
+	suite.Require().Equal([]byte("abcdef"), <-combinedCh)
+}
+
 func (suite *FollowSuite) TestReadWrite() {
 	ctx, ctxCancel := context.WithCancel(context.Background())
 	defer ctxCancel()
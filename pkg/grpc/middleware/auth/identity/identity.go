@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package identity passes the identity of the API client verified by apid
+// to the services behind it.
+//
+// Services behind apid are reached over the local sockets, so they don't see
+// the client certificate: apid forwards the subject and the roles (organizations)
+// of the verified client certificate via the request metadata.
+package identity
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	commonNameKey = "talos-client-cn"
+	rolesKey      = "talos-client-roles"
+)
+
+// Identity of the API client.
+type Identity struct {
+	CommonName string
+	Roles      []string
+}
+
+// FromPeer returns the identity of the client certificate verified by the TLS handshake.
+func FromPeer(ctx context.Context) (*Identity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, false
+	}
+
+	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	crt := tlsInfo.State.VerifiedChains[0][0]
+
+	return &Identity{
+		CommonName: crt.Subject.CommonName,
+		Roles:      append([]string(nil), crt.Subject.Organization...),
+	}, true
+}
+
+// Strip removes the identity from the metadata.
+//
+// Identity passed by the client itself should never be trusted.
+func Strip(md metadata.MD) {
+	delete(md, commonNameKey)
+	delete(md, rolesKey)
+}
+
+// Set replaces the identity in the metadata.
+func Set(md metadata.MD, id *Identity) {
+	Strip(md)
+
+	md.Set(commonNameKey, id.CommonName)
+
+	if len(id.Roles) > 0 {
+		md.Set(rolesKey, id.Roles...)
+	}
+}
+
+// FromIncomingContext returns the identity forwarded by apid.
+func FromIncomingContext(ctx context.Context) (*Identity, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	cn := md.Get(commonNameKey)
+	if len(cn) != 1 {
+		return nil, false
+	}
+
+	return &Identity{
+		CommonName: cn[0],
+		Roles:      md.Get(rolesKey),
+	}, true
+}
+
+// HasRoles checks whether the identity has all the roles.
+func (id *Identity) HasRoles(roles ...string) bool {
+	for _, role := range roles {
+		found := false
+
+		for _, r := range id.Roles {
+			if r == role {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package images
+
+import (
+	"fmt"
+	"strings"
+
+	criconstants "github.com/containerd/cri/pkg/constants"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// CachedImage is an image stored in the image cache partition.
+type CachedImage struct {
+	// Namespace is the containerd namespace the image is imported into.
+	Namespace string
+	Reference string
+}
+
+// String returns the image in the `namespace=reference` format.
+func (img CachedImage) String() string {
+	return img.Namespace + "=" + img.Reference
+}
+
+// ArchiveName returns the file name of the OCI archive in the image cache partition.
+func (img CachedImage) ArchiveName() string {
+	return archiveNameReplacer.Replace(img.Reference) + ".tar"
+}
+
+var archiveNameReplacer = strings.NewReplacer("/", "_", ":", "_", "@", "_")
+
+// ParseCachedImage parses the image in the `namespace=reference` format.
+func ParseCachedImage(s string) (CachedImage, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return CachedImage{}, fmt.Errorf("invalid cached image %q, expected namespace=reference", s)
+	}
+
+	switch parts[0] {
+	case constants.SystemContainerdNamespace, criconstants.K8sContainerdNamespace:
+	default:
+		return CachedImage{}, fmt.Errorf("unsupported namespace %q for cached image %q", parts[0], parts[1])
+	}
+
+	return CachedImage{
+		Namespace: parts[0],
+		Reference: parts[1],
+	}, nil
+}
+
+// CacheList returns the list of images to be stored in the image cache.
+//
+// Etcd image goes to the system namespace, kubelet, Kubernetes images and extra images
+// from the config go to the k8s.io namespace.
+func CacheList(config config.Provider) []CachedImage {
+	images := List(config)
+
+	cached := []CachedImage{
+		{Namespace: criconstants.K8sContainerdNamespace, Reference: images.Kubelet},
+		{Namespace: criconstants.K8sContainerdNamespace, Reference: images.Pause},
+		{Namespace: criconstants.K8sContainerdNamespace, Reference: images.KubeProxy},
+		{Namespace: criconstants.K8sContainerdNamespace, Reference: images.CoreDNS},
+	}
+
+	if config.Machine().Type() != machine.TypeJoin {
+		cached = append(cached,
+			CachedImage{Namespace: constants.SystemContainerdNamespace, Reference: images.Etcd},
+			CachedImage{Namespace: criconstants.K8sContainerdNamespace, Reference: images.KubeAPIServer},
+			CachedImage{Namespace: criconstants.K8sContainerdNamespace, Reference: images.KubeControllerManager},
+			CachedImage{Namespace: criconstants.K8sContainerdNamespace, Reference: images.KubeScheduler},
+		)
+	}
+
+	if config.Cluster().Network().CNI().Name() != constants.CustomCNI {
+		cached = append(cached,
+			CachedImage{Namespace: criconstants.K8sContainerdNamespace, Reference: images.Flannel},
+			CachedImage{Namespace: criconstants.K8sContainerdNamespace, Reference: images.FlannelCNI},
+		)
+	}
+
+	for _, ref := range config.Machine().Install().ImageCache().Images() {
+		cached = append(cached, CachedImage{Namespace: criconstants.K8sContainerdNamespace, Reference: ref})
+	}
+
+	return cached
+}
@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package images_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/images"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+func TestParseCachedImage(t *testing.T) {
+	for _, tt := range []struct {
+		spec          string
+		expected      images.CachedImage
+		expectedError string
+	}{
+		{
+			spec:     "k8s.io=docker.io/library/nginx:1.19",
+			expected: images.CachedImage{Namespace: "k8s.io", Reference: "docker.io/library/nginx:1.19"},
+		},
+		{
+			spec:     "system=gcr.io/etcd-development/etcd:v3.4.14",
+			expected: images.CachedImage{Namespace: "system", Reference: "gcr.io/etcd-development/etcd:v3.4.14"},
+		},
+		{
+			spec:          "docker.io/library/nginx:1.19",
+			expectedError: `invalid cached image "docker.io/library/nginx:1.19", expected namespace=reference`,
+		},
+		{
+			spec:          "k8s.io=",
+			expectedError: `invalid cached image "k8s.io=", expected namespace=reference`,
+		},
+		{
+			spec:          "default=docker.io/library/nginx:1.19",
+			expectedError: `unsupported namespace "default" for cached image "docker.io/library/nginx:1.19"`,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.spec, func(t *testing.T) {
+			img, err := images.ParseCachedImage(tt.spec)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, img)
+			assert.Equal(t, tt.spec, img.String())
+		})
+	}
+}
+
+func TestCachedImageArchiveName(t *testing.T) {
+	assert.Equal(t, "docker.io_library_nginx_1.19.tar",
+		images.CachedImage{Reference: "docker.io/library/nginx:1.19"}.ArchiveName())
+	assert.Equal(t, "ghcr.io_talos-systems_kubelet_sha256_0123.tar",
+		images.CachedImage{Reference: "ghcr.io/talos-systems/kubelet@sha256:0123"}.ArchiveName())
+}
+
+func newCacheConfig(machineType, cni string) *v1alpha1.Config {
+	return &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: machineType,
+			MachineInstall: &v1alpha1.InstallConfig{
+				InstallImageCache: &v1alpha1.InstallImageCacheConfig{
+					ImageCacheEnabled: true,
+					ImageCacheImages:  []string{"docker.io/library/nginx:1.19"},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+				CNI: &v1alpha1.CNIConfig{
+					CNIName: cni,
+				},
+			},
+		},
+	}
+}
+
+func cachedNamespaces(cached []images.CachedImage) map[string]string {
+	namespaces := map[string]string{}
+
+	for _, img := range cached {
+		namespaces[img.Reference] = img.Namespace
+	}
+
+	return namespaces
+}
+
+func TestCacheListControlPlane(t *testing.T) {
+	config := newCacheConfig("controlplane", constants.DefaultCNI)
+	list := images.List(config)
+
+	cached := cachedNamespaces(images.CacheList(config))
+
+	assert.Equal(t, map[string]string{
+		list.Kubelet:               "k8s.io",
+		list.Pause:                 "k8s.io",
+		list.KubeProxy:             "k8s.io",
+		list.CoreDNS:               "k8s.io",
+		list.Etcd:                  "system",
+		list.KubeAPIServer:         "k8s.io",
+		list.KubeControllerManager: "k8s.io",
+		list.KubeScheduler:         "k8s.io",
+		list.Flannel:               "k8s.io",
+		list.FlannelCNI:            "k8s.io",
+
+		"docker.io/library/nginx:1.19": "k8s.io",
+	}, cached)
+}
+
+func TestCacheListWorker(t *testing.T) {
+	config := newCacheConfig("join", constants.CustomCNI)
+	list := images.List(config)
+
+	cached := cachedNamespaces(images.CacheList(config))
+
+	// no control plane images, no flannel for the custom CNI
+	assert.Equal(t, map[string]string{
+		list.Kubelet:   "k8s.io",
+		list.Pause:     "k8s.io",
+		list.KubeProxy: "k8s.io",
+		list.CoreDNS:   "k8s.io",
+
+		"docker.io/library/nginx:1.19": "k8s.io",
+	}, cached)
+}
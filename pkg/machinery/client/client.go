@@ -29,6 +29,7 @@ import (
 
 	clusterapi "github.com/talos-systems/talos/pkg/machinery/api/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	healthapi "github.com/talos-systems/talos/pkg/machinery/api/health"
 	inspectapi "github.com/talos-systems/talos/pkg/machinery/api/inspect"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	networkapi "github.com/talos-systems/talos/pkg/machinery/api/network"
@@ -52,13 +53,14 @@ type Client struct {
 	options *Options
 	conn    *grpc.ClientConn
 
-	MachineClient  machineapi.MachineServiceClient
-	TimeClient     timeapi.TimeServiceClient
-	NetworkClient  networkapi.NetworkServiceClient
-	ClusterClient  clusterapi.ClusterServiceClient
-	StorageClient  storageapi.StorageServiceClient
-	ResourceClient resourceapi.ResourceServiceClient
-	InspectClient  inspectapi.InspectServiceClient
+	MachineClient    machineapi.MachineServiceClient
+	TimeClient       timeapi.TimeServiceClient
+	NetworkClient    networkapi.NetworkServiceClient
+	ClusterClient    clusterapi.ClusterServiceClient
+	StorageClient    storageapi.StorageServiceClient
+	ResourceClient   resourceapi.ResourceServiceClient
+	InspectClient    inspectapi.InspectServiceClient
+	NodeHealthClient healthapi.NodeHealthServiceClient
 
 	Resources *ResourcesClient
 	Inspect   *InspectClient
@@ -159,6 +161,7 @@ func New(ctx context.Context, opts ...OptionFunc) (c *Client, err error) {
 	c.StorageClient = storageapi.NewStorageServiceClient(c.conn)
 	c.ResourceClient = resourceapi.NewResourceServiceClient(c.conn)
 	c.InspectClient = inspectapi.NewInspectServiceClient(c.conn)
+	c.NodeHealthClient = healthapi.NewNodeHealthServiceClient(c.conn)
 
 	c.Resources = &ResourcesClient{c.ResourceClient}
 	c.Inspect = &InspectClient{c.InspectClient}
@@ -387,6 +390,11 @@ func (c *Client) ApplyConfiguration(ctx context.Context, req *machineapi.ApplyCo
 	return c.MachineClient.ApplyConfiguration(ctx, req, callOptions...)
 }
 
+// GenerateClientCertificate implements proto.MachineServiceClient interface.
+func (c *Client) GenerateClientCertificate(ctx context.Context, req *machineapi.GenerateClientCertificateRequest, callOptions ...grpc.CallOption) (resp *machineapi.GenerateClientCertificateResponse, err error) {
+	return c.MachineClient.GenerateClientCertificate(ctx, req, callOptions...)
+}
+
 // GenerateConfiguration implements proto.MachineServiceClient interface.
 func (c *Client) GenerateConfiguration(ctx context.Context, req *machineapi.GenerateConfigurationRequest, callOptions ...grpc.CallOption) (resp *machineapi.GenerateConfigurationResponse, err error) {
 	return c.MachineClient.GenerateConfiguration(ctx, req, callOptions...)
@@ -657,6 +665,87 @@ func (c *Client) Copy(ctx context.Context, rootPath string) (io.ReadCloser, <-ch
 	return ReadStream(stream)
 }
 
+// ImageList lists container images in the containerd namespace.
+func (c *Client) ImageList(ctx context.Context, namespace string, callOptions ...grpc.CallOption) (machineapi.MachineService_ImageListClient, error) {
+	return c.MachineClient.ImageList(ctx, &machineapi.ImageListRequest{
+		Namespace: namespace,
+	}, callOptions...)
+}
+
+// ImagePull pulls container image into the containerd namespace streaming back pull progress.
+func (c *Client) ImagePull(ctx context.Context, namespace, reference string, callOptions ...grpc.CallOption) (machineapi.MachineService_ImagePullClient, error) {
+	return c.MachineClient.ImagePull(ctx, &machineapi.ImagePullRequest{
+		Namespace: namespace,
+		Reference: reference,
+	}, callOptions...)
+}
+
+// ImageRemove removes container image from the containerd namespace.
+func (c *Client) ImageRemove(ctx context.Context, namespace, reference string, callOptions ...grpc.CallOption) (resp *machineapi.ImageRemoveResponse, err error) {
+	resp, err = c.MachineClient.ImageRemove(ctx, &machineapi.ImageRemoveRequest{
+		Namespace: namespace,
+		Reference: reference,
+	}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ImageRemoveResponse) //nolint: errcheck
+
+	return
+}
+
+// Upload streams .tar.gz archive from r to the node and extracts it under the destination path.
+func (c *Client) Upload(ctx context.Context, path string, r io.Reader, callOptions ...grpc.CallOption) (resp *machineapi.UploadResponse, err error) {
+	stream, err := c.MachineClient.Upload(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Send(&machineapi.UploadRequest{Path: path}); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 32*1024)
+
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			if err = stream.Send(&machineapi.UploadRequest{Bytes: buf[:n]}); err != nil {
+				break
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			stream.CloseSend() //nolint: errcheck
+
+			return nil, readErr
+		}
+	}
+
+	// error from Send is reported by CloseAndRecv
+	resp, err = stream.CloseAndRecv()
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UploadResponse) //nolint: errcheck
+
+	return
+}
+
+// PacketCapture captures packets on the node and returns the stream of pcap-ng data.
+func (c *Client) PacketCapture(ctx context.Context, req *machineapi.PacketCaptureRequest) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.PacketCapture(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
 // Upgrade initiates a Talos upgrade ... and implements the proto.MachineServiceClient
 // interface.
 func (c *Client) Upgrade(ctx context.Context, image string, preserve, stage bool, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
@@ -832,12 +921,48 @@ func (c *Client) ClusterHealthCheck(ctx context.Context, waitTimeout time.Durati
 	})
 }
 
+// NodeReady returns node readiness aggregated from the health of the node services.
+func (c *Client) NodeReady(ctx context.Context, callOptions ...grpc.CallOption) (resp *healthapi.NodeReadyResponse, err error) {
+	resp, err = c.NodeHealthClient.NodeReady(
+		ctx,
+		&empty.Empty{},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*healthapi.NodeReadyResponse) //nolint: errcheck
+
+	return
+}
+
 // MachineStream is a common interface for streams returned by streaming APIs.
 type MachineStream interface {
 	Recv() (*common.Data, error)
 	grpc.ClientStream
 }
 
+// DmesgDataStream adapts Dmesg stream to the MachineStream interface, so that it can be used with ReadStream.
+func DmesgDataStream(stream machineapi.MachineService_DmesgClient) MachineStream {
+	return dmesgDataStream{stream}
+}
+
+type dmesgDataStream struct {
+	machineapi.MachineService_DmesgClient
+}
+
+func (s dmesgDataStream) Recv() (*common.Data, error) {
+	msg, err := s.MachineService_DmesgClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.Data{
+		Metadata: msg.Metadata,
+		Bytes:    msg.Bytes,
+	}, nil
+}
+
 // ReadStream converts grpc stream into io.Reader.
 func ReadStream(stream MachineStream) (io.ReadCloser, <-chan error, error) {
 	errCh := make(chan error, 1)
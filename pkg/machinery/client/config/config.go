@@ -6,6 +6,9 @@ package config
 
 import (
 	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -44,6 +47,21 @@ func (c *Context) upgrade() {
 	}
 }
 
+// Certificate returns parsed client certificate of the context.
+func (c *Context) Certificate() (*x509.Certificate, error) {
+	crtPEM, err := base64.StdEncoding.DecodeString(c.Crt)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding certificate: %w", err)
+	}
+
+	block, _ := pem.Decode(crtPEM)
+	if block == nil {
+		return nil, fmt.Errorf("error decoding certificate PEM")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
 // Open reads the config and initializes a Config struct.
 func Open(p string) (c *Config, err error) {
 	if err = ensure(p); err != nil {
@@ -5,9 +5,12 @@
 package config_test
 
 import (
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
 
 	"github.com/talos-systems/talos/pkg/machinery/client/config"
 )
@@ -95,3 +98,19 @@ func TestConfigMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestContextCertificate(t *testing.T) {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("os:admin"))
+	require.NoError(t, err)
+
+	context := &config.Context{
+		Crt: base64.StdEncoding.EncodeToString(ca.CrtPEM),
+	}
+
+	crt, err := context.Certificate()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"os:admin"}, crt.Subject.Organization)
+
+	_, err = (&config.Context{Crt: "not base64"}).Certificate()
+	assert.Error(t, err)
+}
@@ -21,3 +21,18 @@ func WithNodes(ctx context.Context, nodes ...string) context.Context {
 
 	return metadata.NewOutgoingContext(ctx, md)
 }
+
+// WithNodesSelector wraps the context with metadata to send request to set of nodes matching the selector.
+//
+// Nodes are resolved by the apid the request is sent to, supported selectors are
+// "all", "role=controlplane" and "role=worker".
+func WithNodesSelector(ctx context.Context, selectors ...string) context.Context {
+	if len(selectors) == 0 {
+		return ctx
+	}
+
+	md := metadata.New(nil)
+	md.Set("selector", selectors...)
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -6,6 +6,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 )
@@ -45,6 +47,54 @@ func WithTailDuration(dur time.Duration) EventsOptionFunc {
 	}
 }
 
+// WithBoot sets up Events API to return persisted events of the boot relative to the current one.
+//
+// Zero is the current boot, -1 is the previous boot, and so on.
+func WithBoot(boot int32) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.Boot = boot
+	}
+}
+
+// WithBootID sets up Events API to return persisted events of the specified boot.
+func WithBootID(bootID string) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.BootId = bootID
+	}
+}
+
+// WithTimeRange sets up Events API to return events in the specified time range.
+//
+// Zero since or until leaves the range open on that side.
+func WithTimeRange(since, until time.Time) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		if !since.IsZero() {
+			opts.Since = timestamppb.New(since)
+		}
+
+		if !until.IsZero() {
+			opts.Until = timestamppb.New(until)
+		}
+	}
+}
+
+// WithEventTypes sets up Events API to return only events of the specified types.
+//
+// Types are specified as event message names, e.g. "ServiceStateEvent".
+func WithEventTypes(types ...string) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.Types = types
+	}
+}
+
+// WithEventNames sets up Events API to return only events with the specified sequence,
+// phase, task, service, link or etcd member name.
+func WithEventNames(names ...string) EventsOptionFunc {
+	return func(opts *machineapi.EventsRequest) {
+		opts.Names = names
+	}
+}
+
 // Events implements the proto.OSClient interface.
 func (c *Client) Events(ctx context.Context, opts ...EventsOptionFunc) (stream machineapi.MachineService_EventsClient, err error) {
 	var req machineapi.EventsRequest
@@ -61,12 +111,23 @@ type Event struct {
 	Node    string
 	TypeURL string
 	ID      string
+	BootID  string
 	Payload proto.Message
+
+	// Error is set if the node failed to stream events, Payload is nil in that case.
+	Error error
 }
 
+const (
+	eventsReconnectMinInterval = time.Second
+	eventsReconnectMaxInterval = 30 * time.Second
+)
+
 // EventsWatch wraps Events by providing more simple interface.
 //
-//nolint: gocyclo
+// If the request is proxied to several nodes, errors of individual nodes are delivered as events
+// with Error set, and the rest of the nodes keep streaming. Nodes which became unavailable (e.g. on reboot)
+// are reconnected with backoff, and the stream is resumed after the last event received from the node.
 func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event), opts ...EventsOptionFunc) error {
 	stream, err := c.Events(ctx, opts...)
 	if err != nil {
@@ -77,13 +138,22 @@ func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event),
 		return err
 	}
 
-	defaultNode := RemotePeer(stream.Context())
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch := make(chan Event)
+
+	watcher := &eventsWatcher{
+		client:   c,
+		ch:       ch,
+		opts:     opts,
+		lastIDs:  map[string]string{},
+		backoffs: map[string]time.Duration{},
+	}
 
 	var wg sync.WaitGroup
 
 	defer wg.Wait()
-
-	ch := make(chan Event)
 	defer close(ch)
 
 	wg.Add(1)
@@ -94,6 +164,36 @@ func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event),
 		watchFunc(ch)
 	}()
 
+	err = watcher.receive(ctx, stream, RemotePeer(stream.Context()))
+	if err != nil {
+		// stop reconnecting nodes, as the whole stream failed
+		cancel()
+	}
+
+	watcher.wg.Wait()
+
+	if err != nil {
+		return fmt.Errorf("failed to watch events: %w", err)
+	}
+
+	return nil
+}
+
+type eventsWatcher struct {
+	client *Client
+	ch     chan<- Event
+	opts   []EventsOptionFunc
+
+	// wg tracks goroutines reconnecting to the nodes
+	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	lastIDs  map[string]string
+	backoffs map[string]time.Duration
+}
+
+// receive delivers events from the stream until the stream is closed.
+func (w *eventsWatcher) receive(ctx context.Context, stream machineapi.MachineService_EventsClient, defaultNode string) error {
 	for {
 		event, err := stream.Recv()
 		if err != nil {
@@ -101,52 +201,157 @@ func (c *Client) EventsWatch(ctx context.Context, watchFunc func(<-chan Event),
 				return nil
 			}
 
-			return fmt.Errorf("failed to watch events: %w", err)
+			return err
 		}
 
-		typeURL := event.GetData().GetTypeUrl()
+		node := defaultNode
 
-		var msg proto.Message
+		if event.Metadata != nil {
+			node = event.Metadata.Hostname
 
-		for _, eventType := range []proto.Message{
-			&machineapi.SequenceEvent{},
-			&machineapi.PhaseEvent{},
-			&machineapi.TaskEvent{},
-			&machineapi.ServiceStateEvent{},
-		} {
-			if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
-				msg = eventType
+			if event.Metadata.Error != "" {
+				w.failed(ctx, node, status.FromProto(event.Metadata.Status).Err(), event.Metadata.Error)
 
-				break
+				continue
 			}
 		}
 
-		if msg == nil {
-			// We haven't implemented the handling of this event yet.
+		w.delivered(node, event.Id)
+
+		ev, ok := decodeEvent(event)
+		if !ok {
 			continue
 		}
 
-		if err = proto.Unmarshal(event.GetData().GetValue(), msg); err != nil {
-			log.Printf("failed to unmarshal message: %v", err) // TODO: this should be fixed to return errors
+		ev.Node = node
 
-			continue
+		if !w.send(ctx, ev) {
+			return nil
 		}
+	}
+}
 
-		ev := Event{
-			Node:    defaultNode,
-			TypeURL: typeURL,
-			ID:      event.Id,
-			Payload: msg,
-		}
+func (w *eventsWatcher) send(ctx context.Context, ev Event) bool {
+	select {
+	case w.ch <- ev:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
 
-		if event.Metadata != nil {
-			ev.Node = event.Metadata.Hostname
-		}
+func (w *eventsWatcher) delivered(node, id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lastIDs[node] = id
+	delete(w.backoffs, node)
+}
+
+// failed reports node error and schedules reconnect if the node is unavailable.
+func (w *eventsWatcher) failed(ctx context.Context, node string, err error, message string) {
+	if !w.send(ctx, Event{Node: node, Error: errors.New(message)}) {
+		return
+	}
+
+	if status.Code(err) != codes.Unavailable {
+		return
+	}
+
+	w.mu.Lock()
+
+	interval := w.backoffs[node] * 2
+
+	switch {
+	case interval == 0:
+		interval = eventsReconnectMinInterval
+	case interval > eventsReconnectMaxInterval:
+		interval = eventsReconnectMaxInterval
+	}
+
+	w.backoffs[node] = interval
+
+	w.mu.Unlock()
+
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
 
 		select {
-		case ch <- ev:
 		case <-ctx.Done():
-			return nil
+			return
+		case <-time.After(interval):
+		}
+
+		if reconnectErr := w.reconnect(ctx, node); reconnectErr != nil {
+			w.failed(ctx, node, reconnectErr, reconnectErr.Error())
 		}
+	}()
+}
+
+// reconnect resumes streaming events from the node after the last received event.
+func (w *eventsWatcher) reconnect(ctx context.Context, node string) error {
+	w.mu.Lock()
+	lastID := w.lastIDs[node]
+	w.mu.Unlock()
+
+	opts := w.opts
+
+	if lastID != "" {
+		opts = []EventsOptionFunc{WithTailID(lastID)}
 	}
+
+	stream, err := w.client.Events(WithNodes(ctx, node), opts...)
+	if err != nil {
+		return err
+	}
+
+	if err = stream.CloseSend(); err != nil {
+		return err
+	}
+
+	return w.receive(ctx, stream, node)
+}
+
+func decodeEvent(event *machineapi.Event) (Event, bool) {
+	typeURL := event.GetData().GetTypeUrl()
+
+	var msg proto.Message
+
+	for _, eventType := range []proto.Message{
+		&machineapi.SequenceEvent{},
+		&machineapi.PhaseEvent{},
+		&machineapi.TaskEvent{},
+		&machineapi.ServiceStateEvent{},
+		&machineapi.UpgradeTrialEvent{},
+		&machineapi.ConfigEvent{},
+		&machineapi.AddressEvent{},
+		&machineapi.TimeSyncEvent{},
+		&machineapi.EtcdMemberEvent{},
+	} {
+		if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
+			msg = eventType
+
+			break
+		}
+	}
+
+	if msg == nil {
+		// We haven't implemented the handling of this event yet.
+		return Event{}, false
+	}
+
+	if err := proto.Unmarshal(event.GetData().GetValue(), msg); err != nil {
+		log.Printf("failed to unmarshal message: %v", err) // TODO: this should be fixed to return errors
+
+		return Event{}, false
+	}
+
+	return Event{
+		TypeURL: typeURL,
+		ID:      event.Id,
+		BootID:  event.BootId,
+		Payload: msg,
+	}, true
 }
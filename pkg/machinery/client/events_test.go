@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+type mockEventsStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context
+	events []*machine.Event
+}
+
+func (stream *mockEventsStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *mockEventsStream) CloseSend() error {
+	return nil
+}
+
+func (stream *mockEventsStream) Recv() (*machine.Event, error) {
+	if len(stream.events) == 0 {
+		return nil, io.EOF
+	}
+
+	event := stream.events[0]
+	stream.events = stream.events[1:]
+
+	return event, nil
+}
+
+type mockMachineClient struct {
+	machine.MachineServiceClient
+
+	mu       sync.Mutex
+	requests []*machine.EventsRequest
+	nodes    [][]string
+	streams  []*mockEventsStream
+}
+
+func (c *mockMachineClient) Events(ctx context.Context, in *machine.EventsRequest, opts ...grpc.CallOption) (machine.MachineService_EventsClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	c.requests = append(c.requests, in)
+	c.nodes = append(c.nodes, md.Get("nodes"))
+
+	stream := c.streams[0]
+	c.streams = c.streams[1:]
+	stream.ctx = ctx
+
+	return stream, nil
+}
+
+func sequenceEvent(t *testing.T, node, id string) *machine.Event {
+	payload, err := proto.Marshal(&machine.SequenceEvent{Sequence: "boot"})
+	require.NoError(t, err)
+
+	return &machine.Event{
+		Metadata: &common.Metadata{
+			Hostname: node,
+		},
+		Data: &anypb.Any{
+			TypeUrl: "talos/runtime/machine.SequenceEvent",
+			Value:   payload,
+		},
+		Id: id,
+	}
+}
+
+func TestEventsWatchReconnect(t *testing.T) {
+	machineClient := &mockMachineClient{
+		streams: []*mockEventsStream{
+			{
+				events: []*machine.Event{
+					sequenceEvent(t, "node1", "1"),
+					sequenceEvent(t, "node2", "2"),
+					{
+						Metadata: &common.Metadata{
+							Hostname: "node2",
+							Error:    "connection lost",
+							Status: &rpcstatus.Status{
+								Code:    int32(codes.Unavailable),
+								Message: "connection lost",
+							},
+						},
+					},
+					{
+						Metadata: &common.Metadata{
+							Hostname: "node3",
+							Error:    "permission denied",
+							Status: &rpcstatus.Status{
+								Code:    int32(codes.PermissionDenied),
+								Message: "permission denied",
+							},
+						},
+					},
+					sequenceEvent(t, "node1", "3"),
+				},
+			},
+			{
+				events: []*machine.Event{
+					sequenceEvent(t, "node2", "4"),
+				},
+			},
+		},
+	}
+
+	c := &client.Client{
+		MachineClient: machineClient,
+	}
+
+	var events []client.Event
+
+	ctx := client.WithNodes(context.Background(), "node1", "node2", "node3")
+
+	require.NoError(t, c.EventsWatch(ctx, func(ch <-chan client.Event) {
+		for event := range ch {
+			events = append(events, event)
+		}
+	}, client.WithTailEvents(5)))
+
+	require.Len(t, events, 6)
+
+	for i, expected := range []struct {
+		node string
+		id   string
+		err  string
+	}{
+		{node: "node1", id: "1"},
+		{node: "node2", id: "2"},
+		{node: "node2", err: "connection lost"},
+		{node: "node3", err: "permission denied"},
+		{node: "node1", id: "3"},
+		{node: "node2", id: "4"},
+	} {
+		assert.Equal(t, expected.node, events[i].Node)
+		assert.Equal(t, expected.id, events[i].ID)
+
+		if expected.err != "" {
+			assert.EqualError(t, events[i].Error, expected.err)
+			assert.Nil(t, events[i].Payload)
+		} else {
+			assert.NoError(t, events[i].Error)
+			assert.IsType(t, &machine.SequenceEvent{}, events[i].Payload)
+		}
+	}
+
+	// only node2 is reconnected, and it is resumed after the last received event
+	require.Len(t, machineClient.requests, 2)
+	assert.Equal(t, []string{"node2"}, machineClient.nodes[1])
+	assert.Equal(t, "2", machineClient.requests[1].TailId)
+	assert.EqualValues(t, 0, machineClient.requests[1].TailEvents)
+}
@@ -29,8 +29,12 @@ type Provider interface {
 	ApplyDynamicConfig(context.Context, DynamicConfigProvider) error
 	String() (string, error)
 	Bytes() ([]byte, error)
+	Redact(replacement string)
 }
 
+// RedactedValue is the default replacement for the redacted secret values.
+const RedactedValue = "******"
+
 // MachineConfig defines the requirements for a config that pertains to machine
 // related options.
 type MachineConfig interface {
@@ -45,6 +49,10 @@ type MachineConfig interface {
 	Kubelet() Kubelet
 	Sysctls() map[string]string
 	Registries() Registries
+	Metrics() Metrics
+	Events() Events
+	Logging() Logging
+	HealthChecks() []HealthCheck
 }
 
 // Disk represents the options available for partitioning, formatting, and
@@ -79,6 +87,16 @@ type Install interface {
 	ExtraKernelArgs() []string
 	Zero() bool
 	WithBootloader() bool
+	UpgradeHealthTimeout() time.Duration
+	ImageCache() ImageCache
+}
+
+// ImageCache defines the requirements for a config that pertains to the
+// install time container image cache.
+type ImageCache interface {
+	Enabled() bool
+	Images() []string
+	Path() string
 }
 
 // Security defines the requirements for a config that pertains to security
@@ -194,6 +212,59 @@ type Time interface {
 	Servers() []string
 }
 
+// Metrics defines the requirements for a config that pertains to metrics
+// endpoint related options.
+type Metrics interface {
+	BindAddress() string
+}
+
+// Events defines the requirements for a config that pertains to machine
+// events persistence options.
+type Events interface {
+	Persist() bool
+	Partition() string
+	MaxSize() int64
+}
+
+// Logging defines the requirements for a config that pertains to service
+// log rotation and retention options.
+type Logging interface {
+	MaxFileSize() int64
+	MaxAge() time.Duration
+	MaxTotalSize() int64
+}
+
+// HealthCheck defines the requirements for a config that pertains to custom
+// health check options.
+//
+// Exactly one of HTTP, TCP and Exec is not nil.
+type HealthCheck interface {
+	Name() string
+	HTTP() HTTPHealthCheck
+	TCP() TCPHealthCheck
+	Exec() ExecHealthCheck
+	Interval() time.Duration
+	Timeout() time.Duration
+	FailureThreshold() int
+}
+
+// HTTPHealthCheck defines the HTTP health check options.
+type HTTPHealthCheck interface {
+	URL() string
+}
+
+// TCPHealthCheck defines the TCP health check options.
+type TCPHealthCheck interface {
+	Address() string
+}
+
+// ExecHealthCheck defines the options of the health check running a command in the container.
+type ExecHealthCheck interface {
+	Namespace() string
+	Container() string
+	Command() []string
+}
+
 // Kubelet defines the requirements for a config that pertains to kubelet
 // related options.
 type Kubelet interface {
@@ -213,6 +284,8 @@ type Registries interface {
 // RegistryMirrorConfig represents mirror configuration for a registry.
 type RegistryMirrorConfig interface {
 	Endpoints() []string
+	AppendV2Path() bool
+	SkipFallback() bool
 }
 
 // RegistryConfig specifies auth & TLS config per registry.
@@ -259,10 +332,20 @@ type ClusterConfig interface {
 	CoreDNS() CoreDNS
 	ExtraManifestURLs() []string
 	ExtraManifestHeaderMap() map[string]string
+	ExtraManifestDigestMap() map[string]string
+	ExtraManifestTemplating() bool
+	InlineManifests() []InlineManifest
 	AdminKubeconfig() AdminKubeconfig
 	ScheduleOnMasters() bool
 }
 
+// InlineManifest defines the requirements for a config that pertains to the
+// inline bootstrap manifests.
+type InlineManifest interface {
+	Name() string
+	Contents() string
+}
+
 // ClusterNetwork defines the requirements for a config that pertains to cluster
 // network options.
 type ClusterNetwork interface {
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generate
+
+import (
+	"bytes"
+	"encoding/pem"
+
+	"github.com/talos-systems/crypto/x509"
+)
+
+// NewCABundle builds a CA which trusts several certificate authorities at once.
+//
+// Certificate of the signing CA goes first in the bundle, as the first PEM block
+// is used to issue certificates, and the key is always the key of the signing CA.
+// Certificates of other trusted CAs are appended, duplicates are skipped.
+func NewCABundle(signing *x509.PEMEncodedCertificateAndKey, trusted ...*x509.PEMEncodedCertificateAndKey) *x509.PEMEncodedCertificateAndKey {
+	var (
+		buf  bytes.Buffer
+		seen [][]byte
+	)
+
+	for _, ca := range append([]*x509.PEMEncodedCertificateAndKey{signing}, trusted...) {
+		if ca == nil {
+			continue
+		}
+
+		for _, block := range CABundleCertificates(ca.Crt) {
+			duplicate := false
+
+			for _, der := range seen {
+				if bytes.Equal(der, block.Bytes) {
+					duplicate = true
+
+					break
+				}
+			}
+
+			if duplicate {
+				continue
+			}
+
+			seen = append(seen, block.Bytes)
+
+			buf.Write(pem.EncodeToMemory(block))
+		}
+	}
+
+	return &x509.PEMEncodedCertificateAndKey{
+		Crt: buf.Bytes(),
+		Key: signing.Key,
+	}
+}
+
+// CABundleCertificates returns PEM blocks of all the certificates in the CA bundle.
+func CABundleCertificates(bundle []byte) []*pem.Block {
+	var blocks []*pem.Block
+
+	for {
+		var block *pem.Block
+
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return blocks
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		blocks = append(blocks, block)
+	}
+}
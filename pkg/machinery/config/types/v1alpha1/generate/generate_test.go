@@ -5,9 +5,13 @@
 package generate_test
 
 import (
+	stdlibx509 "crypto/x509"
+	"encoding/pem"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/crypto/x509"
 
 	genv1alpha1 "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -51,3 +55,29 @@ func (suite *GenerateSuite) TestGenerateTalosconfigSuccess() {
 	_, err := genv1alpha1.Talosconfig(suite.input)
 	suite.Require().NoError(err)
 }
+
+func (suite *GenerateSuite) TestCABundle() {
+	oldCA, err := genv1alpha1.NewTalosCA(time.Now())
+	suite.Require().NoError(err)
+
+	newCA, err := genv1alpha1.NewTalosCA(time.Now())
+	suite.Require().NoError(err)
+
+	oldPEM := x509.NewCertificateAndKeyFromCertificateAuthority(oldCA)
+	newPEM := x509.NewCertificateAndKeyFromCertificateAuthority(newCA)
+
+	bundle := genv1alpha1.NewCABundle(newPEM, oldPEM)
+	suite.Assert().Equal(newPEM.Key, bundle.Key)
+
+	certs := genv1alpha1.CABundleCertificates(bundle.Crt)
+	suite.Require().Len(certs, 2)
+	suite.Assert().Equal(newPEM.Crt, pem.EncodeToMemory(certs[0]))
+	suite.Assert().Equal(oldPEM.Crt, pem.EncodeToMemory(certs[1]))
+
+	// bundling again shouldn't duplicate certificates
+	bundle = genv1alpha1.NewCABundle(bundle, oldPEM, newPEM)
+	suite.Assert().Len(genv1alpha1.CABundleCertificates(bundle.Crt), 2)
+
+	pool := stdlibx509.NewCertPool()
+	suite.Assert().True(pool.AppendCertsFromPEM(bundle.Crt))
+}
@@ -162,6 +162,44 @@ func (m *MachineConfig) Time() config.Time {
 	return m.MachineTime
 }
 
+// Metrics implements the config.Provider interface.
+func (m *MachineConfig) Metrics() config.Metrics {
+	if m.MachineMetrics == nil {
+		return &MetricsConfig{}
+	}
+
+	return m.MachineMetrics
+}
+
+// Events implements the config.Provider interface.
+func (m *MachineConfig) Events() config.Events {
+	if m.MachineEvents == nil {
+		return &EventsConfig{}
+	}
+
+	return m.MachineEvents
+}
+
+// Logging implements the config.Provider interface.
+func (m *MachineConfig) Logging() config.Logging {
+	if m.MachineLogging == nil {
+		return &LoggingConfig{}
+	}
+
+	return m.MachineLogging
+}
+
+// HealthChecks implements the config.Provider interface.
+func (m *MachineConfig) HealthChecks() []config.HealthCheck {
+	checks := make([]config.HealthCheck, len(m.MachineHealthChecks))
+
+	for i := range m.MachineHealthChecks {
+		checks[i] = m.MachineHealthChecks[i]
+	}
+
+	return checks
+}
+
 // Kubelet implements the config.Provider interface.
 func (m *MachineConfig) Kubelet() config.Kubelet {
 	if m.MachineKubelet == nil {
@@ -432,6 +470,10 @@ func (s *SchedulerConfig) ExtraArgs() map[string]string {
 
 // Etcd implements the config.Provider interface.
 func (c *ClusterConfig) Etcd() config.Etcd {
+	if c.EtcdConfig == nil {
+		return &EtcdConfig{}
+	}
+
 	return c.EtcdConfig
 }
 
@@ -673,6 +715,37 @@ func (c *ClusterConfig) ExtraManifestHeaderMap() map[string]string {
 	return c.ExtraManifestHeaders
 }
 
+// ExtraManifestDigestMap implements the config.Provider interface.
+func (c *ClusterConfig) ExtraManifestDigestMap() map[string]string {
+	return c.ExtraManifestDigests
+}
+
+// ExtraManifestTemplating implements the config.Provider interface.
+func (c *ClusterConfig) ExtraManifestTemplating() bool {
+	return c.TemplateExtraManifests
+}
+
+// InlineManifests implements the config.Provider interface.
+func (c *ClusterConfig) InlineManifests() []config.InlineManifest {
+	manifests := make([]config.InlineManifest, len(c.ClusterInlineManifests))
+
+	for i := range manifests {
+		manifests[i] = c.ClusterInlineManifests[i]
+	}
+
+	return manifests
+}
+
+// Name implements the config.Provider interface.
+func (m ClusterInlineManifest) Name() string {
+	return m.InlineManifestName
+}
+
+// Contents implements the config.Provider interface.
+func (m ClusterInlineManifest) Contents() string {
+	return m.InlineManifestContents
+}
+
 // CoreDNS implements the config.Provider interface.
 func (c *ClusterConfig) CoreDNS() config.CoreDNS {
 	if c.CoreDNSConfig == nil {
@@ -1061,6 +1134,149 @@ func (t *TimeConfig) Servers() []string {
 	return t.TimeServers
 }
 
+// BindAddress implements the config.Provider interface.
+func (m *MetricsConfig) BindAddress() string {
+	return m.MetricsBindAddress
+}
+
+// Persist implements the config.Provider interface.
+func (e *EventsConfig) Persist() bool {
+	return e.EventsPersist
+}
+
+// Partition implements the config.Provider interface.
+func (e *EventsConfig) Partition() string {
+	if e.EventsPartition == "" {
+		return constants.StatePartitionLabel
+	}
+
+	return e.EventsPartition
+}
+
+// MaxSize implements the config.Provider interface.
+func (e *EventsConfig) MaxSize() int64 {
+	if e.EventsMaxSize == 0 {
+		return constants.DefaultEventLogMaxSize
+	}
+
+	return int64(e.EventsMaxSize)
+}
+
+// MaxFileSize implements the config.Provider interface.
+func (l *LoggingConfig) MaxFileSize() int64 {
+	if l.LoggingMaxFileSize == 0 {
+		return constants.DefaultLogMaxFileSize
+	}
+
+	return int64(l.LoggingMaxFileSize)
+}
+
+// MaxAge implements the config.Provider interface.
+func (l *LoggingConfig) MaxAge() time.Duration {
+	if l.LoggingMaxAge == 0 {
+		return constants.DefaultLogMaxAge
+	}
+
+	return l.LoggingMaxAge
+}
+
+// MaxTotalSize implements the config.Provider interface.
+func (l *LoggingConfig) MaxTotalSize() int64 {
+	if l.LoggingMaxTotalSize == 0 {
+		return constants.DefaultLogMaxTotalSize
+	}
+
+	return int64(l.LoggingMaxTotalSize)
+}
+
+// Name implements the config.Provider interface.
+func (h *HealthCheckConfig) Name() string {
+	return h.HealthCheckName
+}
+
+// HTTP implements the config.Provider interface.
+func (h *HealthCheckConfig) HTTP() config.HTTPHealthCheck {
+	if h.HealthCheckHTTP == nil {
+		return nil
+	}
+
+	return h.HealthCheckHTTP
+}
+
+// TCP implements the config.Provider interface.
+func (h *HealthCheckConfig) TCP() config.TCPHealthCheck {
+	if h.HealthCheckTCP == nil {
+		return nil
+	}
+
+	return h.HealthCheckTCP
+}
+
+// Exec implements the config.Provider interface.
+func (h *HealthCheckConfig) Exec() config.ExecHealthCheck {
+	if h.HealthCheckExec == nil {
+		return nil
+	}
+
+	return h.HealthCheckExec
+}
+
+// Interval implements the config.Provider interface.
+func (h *HealthCheckConfig) Interval() time.Duration {
+	if h.HealthCheckInterval == 0 {
+		return constants.DefaultHealthCheckInterval
+	}
+
+	return h.HealthCheckInterval
+}
+
+// Timeout implements the config.Provider interface.
+func (h *HealthCheckConfig) Timeout() time.Duration {
+	if h.HealthCheckTimeout == 0 {
+		return constants.DefaultHealthCheckTimeout
+	}
+
+	return h.HealthCheckTimeout
+}
+
+// FailureThreshold implements the config.Provider interface.
+func (h *HealthCheckConfig) FailureThreshold() int {
+	if h.HealthCheckFailureThreshold == 0 {
+		return 1
+	}
+
+	return h.HealthCheckFailureThreshold
+}
+
+// URL implements the config.Provider interface.
+func (h *HTTPHealthCheckConfig) URL() string {
+	return h.HTTPURL
+}
+
+// Address implements the config.Provider interface.
+func (t *TCPHealthCheckConfig) Address() string {
+	return t.TCPAddress
+}
+
+// Namespace implements the config.Provider interface.
+func (e *ExecHealthCheckConfig) Namespace() string {
+	if e.ExecNamespace == "" {
+		return constants.K8sContainerdNamespace
+	}
+
+	return e.ExecNamespace
+}
+
+// Container implements the config.Provider interface.
+func (e *ExecHealthCheckConfig) Container() string {
+	return e.ExecContainer
+}
+
+// Command implements the config.Provider interface.
+func (e *ExecHealthCheckConfig) Command() []string {
+	return e.ExecCommand
+}
+
 // Image implements the config.Provider interface.
 func (i *InstallConfig) Image() string {
 	return i.InstallImage
@@ -1086,6 +1302,39 @@ func (i *InstallConfig) WithBootloader() bool {
 	return i.InstallBootloader
 }
 
+// UpgradeHealthTimeout implements the config.Provider interface.
+func (i *InstallConfig) UpgradeHealthTimeout() time.Duration {
+	if i.InstallUpgradeHealthTimeout == 0 {
+		return constants.DefaultUpgradeHealthTimeout
+	}
+
+	return i.InstallUpgradeHealthTimeout
+}
+
+// ImageCache implements the config.Provider interface.
+func (i *InstallConfig) ImageCache() config.ImageCache {
+	if i.InstallImageCache == nil {
+		return &InstallImageCacheConfig{}
+	}
+
+	return i.InstallImageCache
+}
+
+// Enabled implements the config.Provider interface.
+func (c *InstallImageCacheConfig) Enabled() bool {
+	return c.ImageCacheEnabled
+}
+
+// Images implements the config.Provider interface.
+func (c *InstallImageCacheConfig) Images() []string {
+	return c.ImageCacheImages
+}
+
+// Path implements the config.Provider interface.
+func (c *InstallImageCacheConfig) Path() string {
+	return c.ImageCachePath
+}
+
 // Image implements the config.Provider interface.
 func (c *CoreDNS) Image() string {
 	coreDNSImage := fmt.Sprintf("%s:%s", constants.CoreDNSImage, constants.DefaultCoreDNSVersion)
@@ -1111,6 +1360,16 @@ func (r *RegistryMirrorConfig) Endpoints() []string {
 	return r.MirrorEndpoints
 }
 
+// AppendV2Path implements the config.Provider interface.
+func (r *RegistryMirrorConfig) AppendV2Path() bool {
+	return r.MirrorAppendV2Path
+}
+
+// SkipFallback implements the config.Provider interface.
+func (r *RegistryMirrorConfig) SkipFallback() bool {
+	return r.MirrorSkipFallback
+}
+
 // Content implements the config.Provider interface.
 func (f *MachineFile) Content() string {
 	return f.FileContent
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"github.com/talos-systems/crypto/x509"
+)
+
+// Redact implements the config.Provider interface.
+//
+// Redact modifies the config in place, so it should be called on a copy of the config
+// which is not used afterwards.
+func (c *Config) Redact(replacement string) {
+	if c.MachineConfig != nil {
+		c.MachineConfig.redact(replacement)
+	}
+
+	if c.ClusterConfig != nil {
+		c.ClusterConfig.redact(replacement)
+	}
+}
+
+func (m *MachineConfig) redact(replacement string) {
+	redactString(&m.MachineToken, replacement)
+	redactCertificateAndKey(m.MachineCA, replacement)
+
+	if m.MachineNetwork != nil {
+		for _, device := range m.MachineNetwork.NetworkInterfaces {
+			if device != nil && device.DeviceWireguardConfig != nil {
+				redactString(&device.DeviceWireguardConfig.WireguardPrivateKey, replacement)
+			}
+		}
+	}
+
+	for _, registry := range m.MachineRegistries.RegistryConfig {
+		if registry == nil {
+			continue
+		}
+
+		if registry.RegistryAuth != nil {
+			redactString(&registry.RegistryAuth.RegistryPassword, replacement)
+			redactString(&registry.RegistryAuth.RegistryAuth, replacement)
+			redactString(&registry.RegistryAuth.RegistryIdentityToken, replacement)
+		}
+
+		if registry.RegistryTLS != nil {
+			redactCertificateAndKey(registry.RegistryTLS.TLSClientIdentity, replacement)
+		}
+	}
+}
+
+func (c *ClusterConfig) redact(replacement string) {
+	redactString(&c.BootstrapToken, replacement)
+	redactString(&c.ClusterAESCBCEncryptionSecret, replacement)
+	redactCertificateAndKey(c.ClusterCA, replacement)
+	redactCertificateAndKey(c.ClusterAggregatorCA, replacement)
+
+	if c.ClusterServiceAccount != nil {
+		redactBytes(&c.ClusterServiceAccount.Key, replacement)
+	}
+
+	if c.EtcdConfig != nil {
+		redactCertificateAndKey(c.EtcdConfig.RootCA, replacement)
+	}
+
+	// headers usually carry the credentials to fetch the manifests (e.g. Authorization)
+	for name, value := range c.ExtraManifestHeaders {
+		redactString(&value, replacement)
+
+		c.ExtraManifestHeaders[name] = value
+	}
+
+	// inline manifests might contain Secrets
+	for i := range c.ClusterInlineManifests {
+		redactString(&c.ClusterInlineManifests[i].InlineManifestContents, replacement)
+	}
+}
+
+// redactString replaces non-empty value with the replacement.
+func redactString(value *string, replacement string) {
+	if *value != "" {
+		*value = replacement
+	}
+}
+
+// redactBytes replaces non-empty value with the replacement.
+func redactBytes(value *[]byte, replacement string) {
+	if len(*value) > 0 {
+		*value = []byte(replacement)
+	}
+}
+
+func redactCertificateAndKey(pair *x509.PEMEncodedCertificateAndKey, replacement string) {
+	if pair != nil {
+		redactBytes(&pair.Key, replacement)
+	}
+}
@@ -0,0 +1,209 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+const (
+	fillValue     = "value"
+	maxFillDepth  = 16
+	redactedValue = config.RedactedValue
+)
+
+// secretFieldRe matches the names of the fields which are expected to hold secrets.
+var secretFieldRe = regexp.MustCompile(`(Key|Token|Secret|Password|Auth)$`)
+
+// secretFields lists the fields which hold secrets, but don't match secretFieldRe.
+//
+// For map fields, every value in the map is a secret.
+var secretFields = map[string]struct{}{
+	"ExtraManifestHeaders":   {},
+	"InlineManifestContents": {},
+}
+
+// nonSecretFields lists the fields which match secretFieldRe, but don't hold secrets.
+var nonSecretFields = map[string]struct{}{
+	"WireguardPublicKey": {},
+}
+
+// TestRedact fills every field of the config, redacts it and verifies that every field
+// which looks like a secret is redacted, while other fields are left intact.
+//
+// If this test fails after a new field was added, either redact the field in Redact()
+// or add it to the nonSecretFields.
+func TestRedact(t *testing.T) {
+	cfg := &v1alpha1.Config{}
+
+	fill(reflect.ValueOf(cfg), 0)
+
+	cfg.Redact(redactedValue)
+
+	var secrets int
+
+	walk(reflect.ValueOf(cfg), "config", "", func(path, fieldName, value string) {
+		_, secret := secretFields[fieldName]
+		_, nonSecret := nonSecretFields[fieldName]
+
+		if (secretFieldRe.MatchString(fieldName) || secret) && !nonSecret {
+			secrets++
+
+			assert.Equal(t, redactedValue, value, "secret field %s is not redacted", path)
+		} else {
+			assert.Equal(t, fillValue, value, "field %s is not expected to be redacted", path)
+		}
+	})
+
+	assert.NotZero(t, secrets)
+}
+
+func TestRedactEmpty(t *testing.T) {
+	cfg := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	}
+
+	cfg.Redact(redactedValue)
+
+	assert.Equal(t, "", cfg.MachineConfig.MachineToken)
+	assert.Equal(t, "", cfg.ClusterConfig.ClusterAESCBCEncryptionSecret)
+}
+
+func TestRedactMaps(t *testing.T) {
+	cfg := &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ExtraManifestHeaders: map[string]string{
+				"Authorization": "Bearer 1234567",
+				"X-Empty":       "",
+			},
+			ExtraManifestDigests: map[string]string{
+				"https://www.example.com/manifest1.yaml": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			ClusterInlineManifests: v1alpha1.ClusterInlineManifests{
+				{
+					InlineManifestName:     "secret",
+					InlineManifestContents: "apiVersion: v1\nkind: Secret\n",
+				},
+			},
+		},
+	}
+
+	cfg.Redact(redactedValue)
+
+	// header names are kept, values are redacted
+	assert.Equal(t, map[string]string{
+		"Authorization": redactedValue,
+		"X-Empty":       "",
+	}, cfg.ClusterConfig.ExtraManifestHeaders)
+
+	assert.Equal(t, map[string]string{
+		"https://www.example.com/manifest1.yaml": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}, cfg.ClusterConfig.ExtraManifestDigests)
+
+	assert.Equal(t, "secret", cfg.ClusterConfig.ClusterInlineManifests[0].InlineManifestName)
+	assert.Equal(t, redactedValue, cfg.ClusterConfig.ClusterInlineManifests[0].InlineManifestContents)
+}
+
+// fill sets every exported string and byte slice to the fillValue, allocating
+// pointers, slices and maps along the way.
+//
+//nolint: gocyclo
+func fill(v reflect.Value, depth int) {
+	if depth > maxFillDepth {
+		return
+	}
+
+	switch v.Kind() { //nolint: exhaustive
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		fill(v.Elem(), depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+
+			fill(v.Field(i), depth+1)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(fillValue))
+
+			return
+		}
+
+		slice := reflect.MakeSlice(v.Type(), 1, 1)
+		fill(slice.Index(0), depth+1)
+		v.Set(slice)
+	case reflect.Map:
+		key := reflect.New(v.Type().Key()).Elem()
+		fill(key, depth+1)
+
+		value := reflect.New(v.Type().Elem()).Elem()
+		fill(value, depth+1)
+
+		m := reflect.MakeMap(v.Type())
+		m.SetMapIndex(key, value)
+		v.Set(m)
+	case reflect.String:
+		v.SetString(fillValue)
+	}
+}
+
+// walk calls the callback for every string and byte slice reachable from the value
+// along with the name of the struct field holding it.
+//
+// Map values are reported with the name of the map field, map keys are expected
+// to be left intact.
+//
+//nolint: gocyclo
+func walk(v reflect.Value, path, fieldName string, callback func(path, fieldName, value string)) {
+	switch v.Kind() { //nolint: exhaustive
+	case reflect.Ptr:
+		if !v.IsNil() {
+			walk(v.Elem(), path, fieldName, callback)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			walk(v.Field(i), path+"."+field.Name, field.Name, callback)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			callback(path, fieldName, string(v.Bytes()))
+
+			return
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			walk(v.Index(i), path+"[]", fieldName, callback)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+
+		for iter.Next() {
+			walk(iter.Key(), path+"{key}", "", callback)
+			walk(iter.Value(), path+"[]", fieldName, callback)
+		}
+	case reflect.String:
+		callback(path, fieldName, v.String())
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	humanize "github.com/dustin/go-humanize"
@@ -189,10 +190,51 @@ var (
 		},
 	}
 
+	machineInstallImageCacheExample = &InstallImageCacheConfig{
+		ImageCacheEnabled: true,
+		ImageCacheImages:  []string{"docker.io/library/nginx:1.19"},
+	}
+
 	machineTimeExample = &TimeConfig{
 		TimeServers: []string{"time.cloudflare.com"},
 	}
 
+	machineMetricsExample = &MetricsConfig{
+		MetricsBindAddress: "0.0.0.0:9101",
+	}
+
+	machineEventsExample = &EventsConfig{
+		EventsPersist:   true,
+		EventsPartition: "STATE",
+		EventsMaxSize:   1048576,
+	}
+
+	machineLoggingExample = &LoggingConfig{
+		LoggingMaxFileSize:  10485760,
+		LoggingMaxAge:       168 * time.Hour,
+		LoggingMaxTotalSize: 104857600,
+	}
+
+	machineHealthChecksExample = []*HealthCheckConfig{
+		{
+			HealthCheckName: "storage-agent",
+			HealthCheckHTTP: &HTTPHealthCheckConfig{
+				HTTPURL: "http://127.0.0.1:9500/healthz",
+			},
+			HealthCheckInterval:         10 * time.Second,
+			HealthCheckTimeout:          time.Second,
+			HealthCheckFailureThreshold: 3,
+		},
+		{
+			HealthCheckName: "storage-csi",
+			HealthCheckExec: &ExecHealthCheckConfig{
+				ExecNamespace: "k8s.io",
+				ExecContainer: "storage/csi-node-abcde:csi-plugin",
+				ExecCommand:   []string{"/bin/csi-probe"},
+			},
+		},
+	}
+
 	machineSysctlsExample map[string]string = map[string]string{
 		"kernel.domainname":   "talos.dev",
 		"net.ipv4.ip_forward": "0",
@@ -291,6 +333,18 @@ var (
 		AdminKubeconfigCertLifetime: time.Hour,
 	}
 
+	clusterInlineManifestsExample = ClusterInlineManifests{
+		{
+			InlineManifestName: "namespace-ci",
+			InlineManifestContents: strings.TrimSpace(`
+apiVersion: v1
+kind: Namespace
+metadata:
+    name: ci
+`),
+		},
+	}
+
 	kubeletExtraMountsExample = []specs.Mount{
 		{
 			Source:      "/var/lib/example",
@@ -533,6 +587,37 @@ type MachineConfig struct {
 	//   examples:
 	//     - value: machineConfigRegistriesExample
 	MachineRegistries RegistriesConfig `yaml:"registries,omitempty"`
+	//   description: |
+	//     Used to configure the machine's metrics endpoint.
+	//
+	//     Metrics endpoint exports system and service metrics in Prometheus text format.
+	//   examples:
+	//     - value: machineMetricsExample
+	MachineMetrics *MetricsConfig `yaml:"metrics,omitempty"`
+	//   description: |
+	//     Used to configure persistence of the machine events.
+	//
+	//     By default, events are kept only in memory and lost on reboot.
+	//   examples:
+	//     - value: machineEventsExample
+	MachineEvents *EventsConfig `yaml:"events,omitempty"`
+	//   description: |
+	//     Used to configure rotation and retention of the service logs written to the files.
+	//
+	//     Service logs are written to `/var/log/<service>.log` once the ephemeral partition is mounted.
+	//     Rotated logs are compressed, the oldest rotated logs are removed once the service logs
+	//     on the node grow over the total size limit.
+	//   examples:
+	//     - value: machineLoggingExample
+	MachineLogging *LoggingConfig `yaml:"logging,omitempty"`
+	//   description: |
+	//     Used to configure custom health checks.
+	//
+	//     Each health check is reported as a service `healthcheck-<name>` in the list of services,
+	//     and the node is reported ready only when all the health checks are healthy.
+	//   examples:
+	//     - value: machineHealthChecksExample
+	MachineHealthChecks []*HealthCheckConfig `yaml:"healthChecks,omitempty"`
 }
 
 // ClusterConfig represents the cluster-wide config values.
@@ -625,6 +710,7 @@ type ClusterConfig struct {
 	//   description: |
 	//     A list of urls that point to additional manifests.
 	//     These will get automatically deployed as part of the bootstrap.
+	//     Objects removed from the manifests (or from the removed manifests) are pruned from the cluster.
 	//   examples:
 	//     - value: >
 	//        []string{
@@ -642,6 +728,31 @@ type ClusterConfig struct {
 	//         }
 	ExtraManifestHeaders map[string]string `yaml:"extraManifestHeaders,omitempty"`
 	//   description: |
+	//     A map of extra manifest URLs to the expected SHA-256 digests (hex-encoded) of the manifest contents.
+	//     Manifests which don't match the digest are rejected.
+	//   examples:
+	//     - value: >
+	//         map[string]string{
+	//           "https://www.example.com/manifest1.yaml": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	//         }
+	ExtraManifestDigests map[string]string `yaml:"extraManifestDigests,omitempty"`
+	//   description: |
+	//     Render downloaded extra manifests (including custom CNI manifests) as Go templates.
+	//     Available variables: `.ClusterName`, `.PodCIDR`, `.ServiceCIDR`, `.ControlPlaneEndpoint`.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	TemplateExtraManifests bool `yaml:"templateExtraManifests,omitempty"`
+	//   description: |
+	//     A list of inline Kubernetes manifests.
+	//     These will get automatically deployed as part of the bootstrap.
+	//     Objects removed from the manifests (or from the removed manifests) are pruned from the cluster.
+	//   examples:
+	//     - value: clusterInlineManifestsExample
+	ClusterInlineManifests ClusterInlineManifests `yaml:"inlineManifests,omitempty"`
+	//   description: |
 	//     Settings for admin kubeconfig generation.
 	//     Certificate lifetime can be configured.
 	//   examples:
@@ -741,6 +852,39 @@ type InstallConfig struct {
 	//     - false
 	//     - no
 	InstallWipe bool `yaml:"wipe"`
+	//   description: |
+	//     Time to wait for the node to become healthy after an upgrade (default is 10 minutes).
+	//     If machined, apid and kubelet are not healthy within this window, the node reverts to the previous installation and reboots.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	InstallUpgradeHealthTimeout time.Duration `yaml:"upgradeHealthTimeout,omitempty"`
+	//   description: |
+	//     Configures the container image cache baked into the installation disk.
+	//
+	//     Images required to boot the node (etcd, kubelet and Kubernetes control plane images)
+	//     are copied at install time as OCI archives onto a dedicated `IMAGECACHE` partition
+	//     and imported into containerd on boot, so that the node doesn't need registry access to come up.
+	//   examples:
+	//     - value: machineInstallImageCacheExample
+	InstallImageCache *InstallImageCacheConfig `yaml:"imageCache,omitempty"`
+}
+
+// InstallImageCacheConfig represents the image cache options.
+type InstallImageCacheConfig struct {
+	//   description: |
+	//     Indicates if the image cache partition should be created at install time.
+	ImageCacheEnabled bool `yaml:"enabled"`
+	//   description: |
+	//     Extra images to be cached in addition to the images required by Talos.
+	//     Extra images are imported into the `k8s.io` containerd namespace.
+	//   examples:
+	//     - value: '[]string{"docker.io/library/nginx:1.19"}'
+	ImageCacheImages []string `yaml:"images,omitempty"`
+	//   description: |
+	//     Path on the node to the directory with the OCI archives of the cached images.
+	//     Archives are looked up as `<namespace>/<archive>.tar`, where the archive name is the image reference
+	//     with `/`, `:` and `@` replaced by `_`, e.g. `k8s.io/docker.io_library_nginx_1.19.tar`.
+	//     If not set, the archives bundled into the installer image under `/usr/install/image-cache` are used.
+	ImageCachePath string `yaml:"path,omitempty"`
 }
 
 // TimeConfig represents the options for configuring time on a machine.
@@ -755,6 +899,121 @@ type TimeConfig struct {
 	TimeServers []string `yaml:"servers,omitempty"` // This parameter only supports a single time server.
 }
 
+// MetricsConfig represents the options for configuring the metrics endpoint.
+type MetricsConfig struct {
+	//   description: |
+	//     The address (`host:port`) the metrics endpoint listens on.
+	//     Metrics endpoint is disabled if not set.
+	//
+	//     The endpoint is served over HTTPS at path `/metrics` and requires client certificate
+	//     signed by the Talos CA (`machine.ca`).
+	//   examples:
+	//     - value: '"0.0.0.0:9101"'
+	MetricsBindAddress string `yaml:"bindAddress,omitempty"`
+}
+
+// EventsConfig represents the machine events persistence options.
+type EventsConfig struct {
+	//   description: |
+	//     Persist events to the disk, so that events of the previous boots can be queried.
+	EventsPersist bool `yaml:"persist,omitempty"`
+	//   description: |
+	//     The partition to store the event log on.
+	//     Defaults to `STATE`.
+	//   values:
+	//     - STATE
+	//     - EPHEMERAL
+	EventsPartition string `yaml:"partition,omitempty"`
+	//   description: |
+	//     Maximum size of the event log in bytes, older events are discarded when the log grows over the limit.
+	//     Defaults to 1 MiB.
+	EventsMaxSize int `yaml:"maxSize,omitempty"`
+}
+
+// LoggingConfig represents the service log rotation and retention options.
+type LoggingConfig struct {
+	//   description: |
+	//     Maximum size of the service log file in bytes, the log is rotated when it grows over the limit.
+	//     Defaults to 10 MiB.
+	LoggingMaxFileSize int `yaml:"maxFileSize,omitempty"`
+	//   description: |
+	//     Maximum age of the service log file, the log is rotated when it gets older than the limit.
+	//     Rotated logs older than the limit are removed.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//     Defaults to 168h (7 days).
+	LoggingMaxAge time.Duration `yaml:"maxAge,omitempty"`
+	//   description: |
+	//     Total size of the service logs on the node in bytes, the oldest rotated logs are removed
+	//     when the logs grow over the limit.
+	//     Defaults to 100 MiB.
+	LoggingMaxTotalSize int `yaml:"maxTotalSize,omitempty"`
+}
+
+// HealthCheckConfig represents the custom health check.
+type HealthCheckConfig struct {
+	//   description: |
+	//     The name of the health check.
+	HealthCheckName string `yaml:"name"`
+	//   description: |
+	//     HTTP health check: the check is successful if the HTTP GET request returns 2xx or 3xx status code.
+	HealthCheckHTTP *HTTPHealthCheckConfig `yaml:"http,omitempty"`
+	//   description: |
+	//     TCP health check: the check is successful if the TCP connection can be established.
+	HealthCheckTCP *TCPHealthCheckConfig `yaml:"tcp,omitempty"`
+	//   description: |
+	//     Exec health check: the check is successful if the command run in the container exits with zero exit code.
+	HealthCheckExec *ExecHealthCheckConfig `yaml:"exec,omitempty"`
+	//   description: |
+	//     The interval between the checks.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//     Defaults to 5s.
+	HealthCheckInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     The timeout of a single check.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//     Defaults to 1s.
+	HealthCheckTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     The number of consecutive failures before the check is reported unhealthy.
+	//     Defaults to 1.
+	HealthCheckFailureThreshold int `yaml:"failureThreshold,omitempty"`
+}
+
+// HTTPHealthCheckConfig represents the HTTP health check.
+type HTTPHealthCheckConfig struct {
+	//   description: |
+	//     The URL to send the request to.
+	//   examples:
+	//     - value: '"http://127.0.0.1:9500/healthz"'
+	HTTPURL string `yaml:"url"`
+}
+
+// TCPHealthCheckConfig represents the TCP health check.
+type TCPHealthCheckConfig struct {
+	//   description: |
+	//     The address (`host:port`) to connect to.
+	//   examples:
+	//     - value: '"127.0.0.1:3260"'
+	TCPAddress string `yaml:"address"`
+}
+
+// ExecHealthCheckConfig represents the health check running the command in the container.
+type ExecHealthCheckConfig struct {
+	//   description: |
+	//     The containerd namespace of the container.
+	//     Defaults to `k8s.io`.
+	//   values:
+	//     - system
+	//     - k8s.io
+	ExecNamespace string `yaml:"namespace,omitempty"`
+	//   description: |
+	//     The container ID, for Kubernetes containers `namespace/pod:container` format is accepted.
+	ExecContainer string `yaml:"container"`
+	//   description: |
+	//     The command to run in the container.
+	ExecCommand []string `yaml:"command"`
+}
+
 // RegistriesConfig represents the image pull options.
 type RegistriesConfig struct {
 	//   description: |
@@ -981,6 +1240,24 @@ type AdminKubeconfigConfig struct {
 	AdminKubeconfigCertLifetime time.Duration `yaml:"certLifetime,omitempty"`
 }
 
+// ClusterInlineManifests is a list of ClusterInlineManifest.
+type ClusterInlineManifests []ClusterInlineManifest
+
+// ClusterInlineManifest struct describes inline bootstrap manifests for the user.
+type ClusterInlineManifest struct {
+	//   description: |
+	//     Name of the manifest.
+	//     Name should be unique.
+	//   examples:
+	//     - value: '"csi"'
+	InlineManifestName string `yaml:"name"`
+	//   description: |
+	//     Manifest contents as a string.
+	//   examples:
+	//     - value: '"/* --- */"'
+	InlineManifestContents string `yaml:"contents"`
+}
+
 // MachineDisk represents the options available for partitioning, formatting, and
 // mounting extra disks.
 type MachineDisk struct {
@@ -1330,7 +1607,25 @@ type RegistryMirrorConfig struct {
 	//     List of endpoints (URLs) for registry mirrors to use.
 	//     Endpoint configures HTTP/HTTPS access mode, host name,
 	//     port and path (if path is not set, it defaults to `/v2`).
+	//
+	//     Endpoints are tried in order, the registry itself is used as the last
+	//     endpoint unless `skipFallback` is set.
+	//     Endpoints which fail consistently are skipped for a while.
+	//
+	//     Before Talos 0.9 the registry itself was never used if the endpoints are set,
+	//     set `skipFallback` to keep that behavior.
 	MirrorEndpoints []string `yaml:"endpoints"`
+	//   description: |
+	//     Append `/v2` to the endpoint path unless the path already ends with `/v2`.
+	//
+	//     By default endpoint path is used as is.
+	MirrorAppendV2Path bool `yaml:"appendV2Path,omitempty"`
+	//   description: |
+	//     Don't fall back to the upstream registry if all the endpoints fail.
+	//
+	//     Only the images pulled by Talos follow this setting: CRI (Kubernetes images)
+	//     always falls back to the upstream registry and doesn't skip the failing endpoints.
+	MirrorSkipFallback bool `yaml:"skipFallback,omitempty"`
 }
 
 // RegistryConfig specifies auth & TLS config per registry.
@@ -17,7 +17,15 @@ var (
 	KubeletConfigDoc           encoder.Doc
 	NetworkConfigDoc           encoder.Doc
 	InstallConfigDoc           encoder.Doc
+	InstallImageCacheConfigDoc encoder.Doc
 	TimeConfigDoc              encoder.Doc
+	MetricsConfigDoc           encoder.Doc
+	EventsConfigDoc            encoder.Doc
+	LoggingConfigDoc           encoder.Doc
+	HealthCheckConfigDoc       encoder.Doc
+	HTTPHealthCheckConfigDoc   encoder.Doc
+	TCPHealthCheckConfigDoc    encoder.Doc
+	ExecHealthCheckConfigDoc   encoder.Doc
 	RegistriesConfigDoc        encoder.Doc
 	PodCheckpointerDoc         encoder.Doc
 	CoreDNSDoc                 encoder.Doc
@@ -31,6 +39,7 @@ var (
 	ClusterNetworkConfigDoc    encoder.Doc
 	CNIConfigDoc               encoder.Doc
 	AdminKubeconfigConfigDoc   encoder.Doc
+	ClusterInlineManifestDoc   encoder.Doc
 	MachineDiskDoc             encoder.Doc
 	DiskPartitionDoc           encoder.Doc
 	MachineFileDoc             encoder.Doc
@@ -107,7 +116,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 13)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 17)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -213,6 +222,34 @@ func init() {
 	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
 
 	MachineConfigDoc.Fields[12].AddExample("", machineConfigRegistriesExample)
+	MachineConfigDoc.Fields[13].Name = "metrics"
+	MachineConfigDoc.Fields[13].Type = "MetricsConfig"
+	MachineConfigDoc.Fields[13].Note = ""
+	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's metrics endpoint.\n\nMetrics endpoint exports system and service metrics in Prometheus text format."
+	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's metrics endpoint."
+
+	MachineConfigDoc.Fields[13].AddExample("", machineMetricsExample)
+	MachineConfigDoc.Fields[14].Name = "events"
+	MachineConfigDoc.Fields[14].Type = "EventsConfig"
+	MachineConfigDoc.Fields[14].Note = ""
+	MachineConfigDoc.Fields[14].Description = "Used to configure persistence of the machine events.\n\nBy default, events are kept only in memory and lost on reboot."
+	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure persistence of the machine events."
+
+	MachineConfigDoc.Fields[14].AddExample("", machineEventsExample)
+	MachineConfigDoc.Fields[15].Name = "logging"
+	MachineConfigDoc.Fields[15].Type = "LoggingConfig"
+	MachineConfigDoc.Fields[15].Note = ""
+	MachineConfigDoc.Fields[15].Description = "Used to configure rotation and retention of the service logs written to the files.\n\nService logs are written to `/var/log/<service>.log` once the ephemeral partition is mounted.\nRotated logs are compressed, the oldest rotated logs are removed once the service logs\non the node grow over the total size limit."
+	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure rotation and retention of the service logs written to the files."
+
+	MachineConfigDoc.Fields[15].AddExample("", machineLoggingExample)
+	MachineConfigDoc.Fields[16].Name = "healthChecks"
+	MachineConfigDoc.Fields[16].Type = "[]HealthCheckConfig"
+	MachineConfigDoc.Fields[16].Note = ""
+	MachineConfigDoc.Fields[16].Description = "Used to configure custom health checks.\n\nEach health check is reported as a service `healthcheck-<name>` in the list of services,\nand the node is reported ready only when all the health checks are healthy."
+	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Used to configure custom health checks."
+
+	MachineConfigDoc.Fields[16].AddExample("", machineHealthChecksExample)
 
 	ClusterConfigDoc.Type = "ClusterConfig"
 	ClusterConfigDoc.Comments[encoder.LineComment] = "ClusterConfig represents the cluster-wide config values."
@@ -225,7 +262,7 @@ func init() {
 			FieldName: "cluster",
 		},
 	}
-	ClusterConfigDoc.Fields = make([]encoder.Doc, 19)
+	ClusterConfigDoc.Fields = make([]encoder.Doc, 22)
 	ClusterConfigDoc.Fields[0].Name = "controlPlane"
 	ClusterConfigDoc.Fields[0].Type = "ControlPlaneConfig"
 	ClusterConfigDoc.Fields[0].Note = ""
@@ -332,7 +369,7 @@ func init() {
 	ClusterConfigDoc.Fields[15].Name = "extraManifests"
 	ClusterConfigDoc.Fields[15].Type = "[]string"
 	ClusterConfigDoc.Fields[15].Note = ""
-	ClusterConfigDoc.Fields[15].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[15].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap.\nObjects removed from the manifests (or from the removed manifests) are pruned from the cluster."
 	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
 
 	ClusterConfigDoc.Fields[15].AddExample("", []string{
@@ -349,24 +386,51 @@ func init() {
 		"Token":       "1234567",
 		"X-ExtraInfo": "info",
 	})
-	ClusterConfigDoc.Fields[17].Name = "adminKubeconfig"
-	ClusterConfigDoc.Fields[17].Type = "AdminKubeconfigConfig"
+	ClusterConfigDoc.Fields[17].Name = "extraManifestDigests"
+	ClusterConfigDoc.Fields[17].Type = "map[string]string"
 	ClusterConfigDoc.Fields[17].Note = ""
-	ClusterConfigDoc.Fields[17].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
-	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
+	ClusterConfigDoc.Fields[17].Description = "A map of extra manifest URLs to the expected SHA-256 digests (hex-encoded) of the manifest contents.\nManifests which don't match the digest are rejected."
+	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "A map of extra manifest URLs to the expected SHA-256 digests (hex-encoded) of the manifest contents."
 
-	ClusterConfigDoc.Fields[17].AddExample("", clusterAdminKubeconfigExample)
-	ClusterConfigDoc.Fields[18].Name = "allowSchedulingOnMasters"
+	ClusterConfigDoc.Fields[17].AddExample("", map[string]string{
+		"https://www.example.com/manifest1.yaml": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	})
+	ClusterConfigDoc.Fields[18].Name = "templateExtraManifests"
 	ClusterConfigDoc.Fields[18].Type = "bool"
 	ClusterConfigDoc.Fields[18].Note = ""
-	ClusterConfigDoc.Fields[18].Description = "Allows running workload on master nodes."
-	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "Allows running workload on master nodes."
+	ClusterConfigDoc.Fields[18].Description = "Render downloaded extra manifests (including custom CNI manifests) as Go templates.\nAvailable variables: `.ClusterName`, `.PodCIDR`, `.ServiceCIDR`, `.ControlPlaneEndpoint`."
+	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "Render downloaded extra manifests (including custom CNI manifests) as Go templates."
 	ClusterConfigDoc.Fields[18].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
+	ClusterConfigDoc.Fields[19].Name = "inlineManifests"
+	ClusterConfigDoc.Fields[19].Type = "ClusterInlineManifests"
+	ClusterConfigDoc.Fields[19].Note = ""
+	ClusterConfigDoc.Fields[19].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap.\nObjects removed from the manifests (or from the removed manifests) are pruned from the cluster."
+	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
+
+	ClusterConfigDoc.Fields[19].AddExample("", clusterInlineManifestsExample)
+	ClusterConfigDoc.Fields[20].Name = "adminKubeconfig"
+	ClusterConfigDoc.Fields[20].Type = "AdminKubeconfigConfig"
+	ClusterConfigDoc.Fields[20].Note = ""
+	ClusterConfigDoc.Fields[20].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
+	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
+
+	ClusterConfigDoc.Fields[20].AddExample("", clusterAdminKubeconfigExample)
+	ClusterConfigDoc.Fields[21].Name = "allowSchedulingOnMasters"
+	ClusterConfigDoc.Fields[21].Type = "bool"
+	ClusterConfigDoc.Fields[21].Note = ""
+	ClusterConfigDoc.Fields[21].Description = "Allows running workload on master nodes."
+	ClusterConfigDoc.Fields[21].Comments[encoder.LineComment] = "Allows running workload on master nodes."
+	ClusterConfigDoc.Fields[21].Values = []string{
+		"true",
+		"yes",
+		"false",
+		"no",
+	}
 
 	KubeletConfigDoc.Type = "KubeletConfig"
 	KubeletConfigDoc.Comments[encoder.LineComment] = "KubeletConfig represents the kubelet config values."
@@ -454,7 +518,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 5)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 7)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -500,6 +564,48 @@ func init() {
 		"false",
 		"no",
 	}
+	InstallConfigDoc.Fields[5].Name = "upgradeHealthTimeout"
+	InstallConfigDoc.Fields[5].Type = "Duration"
+	InstallConfigDoc.Fields[5].Note = ""
+	InstallConfigDoc.Fields[5].Description = "Time to wait for the node to become healthy after an upgrade (default is 10 minutes).\nIf machined, apid and kubelet are not healthy within this window, the node reverts to the previous installation and reboots.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	InstallConfigDoc.Fields[5].Comments[encoder.LineComment] = "Time to wait for the node to become healthy after an upgrade (default is 10 minutes)."
+	InstallConfigDoc.Fields[6].Name = "imageCache"
+	InstallConfigDoc.Fields[6].Type = "InstallImageCacheConfig"
+	InstallConfigDoc.Fields[6].Note = ""
+	InstallConfigDoc.Fields[6].Description = "Configures the container image cache baked into the installation disk.\n\nImages required to boot the node (etcd, kubelet and Kubernetes control plane images)\nare copied at install time as OCI archives onto a dedicated `IMAGECACHE` partition\nand imported into containerd on boot, so that the node doesn't need registry access to come up."
+	InstallConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures the container image cache baked into the installation disk."
+
+	InstallConfigDoc.Fields[6].AddExample("", machineInstallImageCacheExample)
+
+	InstallImageCacheConfigDoc.Type = "InstallImageCacheConfig"
+	InstallImageCacheConfigDoc.Comments[encoder.LineComment] = "InstallImageCacheConfig represents the image cache options."
+	InstallImageCacheConfigDoc.Description = "InstallImageCacheConfig represents the image cache options."
+
+	InstallImageCacheConfigDoc.AddExample("", machineInstallImageCacheExample)
+	InstallImageCacheConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "imageCache",
+		},
+	}
+	InstallImageCacheConfigDoc.Fields = make([]encoder.Doc, 3)
+	InstallImageCacheConfigDoc.Fields[0].Name = "enabled"
+	InstallImageCacheConfigDoc.Fields[0].Type = "bool"
+	InstallImageCacheConfigDoc.Fields[0].Note = ""
+	InstallImageCacheConfigDoc.Fields[0].Description = "Indicates if the image cache partition should be created at install time."
+	InstallImageCacheConfigDoc.Fields[0].Comments[encoder.LineComment] = "Indicates if the image cache partition should be created at install time."
+	InstallImageCacheConfigDoc.Fields[1].Name = "images"
+	InstallImageCacheConfigDoc.Fields[1].Type = "[]string"
+	InstallImageCacheConfigDoc.Fields[1].Note = ""
+	InstallImageCacheConfigDoc.Fields[1].Description = "Extra images to be cached in addition to the images required by Talos.\nExtra images are imported into the `k8s.io` containerd namespace."
+	InstallImageCacheConfigDoc.Fields[1].Comments[encoder.LineComment] = "Extra images to be cached in addition to the images required by Talos."
+
+	InstallImageCacheConfigDoc.Fields[1].AddExample("", []string{"docker.io/library/nginx:1.19"})
+	InstallImageCacheConfigDoc.Fields[2].Name = "path"
+	InstallImageCacheConfigDoc.Fields[2].Type = "string"
+	InstallImageCacheConfigDoc.Fields[2].Note = ""
+	InstallImageCacheConfigDoc.Fields[2].Description = "Path on the node to the directory with the OCI archives of the cached images.\nArchives are looked up as `<namespace>/<archive>.tar`, where the archive name is the image reference\nwith `/`, `:` and `@` replaced by `_`, e.g. `k8s.io/docker.io_library_nginx_1.19.tar`.\nIf not set, the archives bundled into the installer image under `/usr/install/image-cache` are used."
+	InstallImageCacheConfigDoc.Fields[2].Comments[encoder.LineComment] = "Path on the node to the directory with the OCI archives of the cached images."
 
 	TimeConfigDoc.Type = "TimeConfig"
 	TimeConfigDoc.Comments[encoder.LineComment] = "TimeConfig represents the options for configuring time on a machine."
@@ -524,6 +630,200 @@ func init() {
 	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`"
 	TimeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies time (NTP) servers to use for setting the system time."
 
+	MetricsConfigDoc.Type = "MetricsConfig"
+	MetricsConfigDoc.Comments[encoder.LineComment] = "MetricsConfig represents the options for configuring the metrics endpoint."
+	MetricsConfigDoc.Description = "MetricsConfig represents the options for configuring the metrics endpoint."
+
+	MetricsConfigDoc.AddExample("", machineMetricsExample)
+	MetricsConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "metrics",
+		},
+	}
+	MetricsConfigDoc.Fields = make([]encoder.Doc, 1)
+	MetricsConfigDoc.Fields[0].Name = "bindAddress"
+	MetricsConfigDoc.Fields[0].Type = "string"
+	MetricsConfigDoc.Fields[0].Note = ""
+	MetricsConfigDoc.Fields[0].Description = "The address (`host:port`) the metrics endpoint listens on.\nMetrics endpoint is disabled if not set.\n\nThe endpoint is served over HTTPS at path `/metrics` and requires client certificate\nsigned by the Talos CA (`machine.ca`)."
+	MetricsConfigDoc.Fields[0].Comments[encoder.LineComment] = "The address (`host:port`) the metrics endpoint listens on."
+
+	MetricsConfigDoc.Fields[0].AddExample("", "0.0.0.0:9101")
+
+	EventsConfigDoc.Type = "EventsConfig"
+	EventsConfigDoc.Comments[encoder.LineComment] = "EventsConfig represents the machine events persistence options."
+	EventsConfigDoc.Description = "EventsConfig represents the machine events persistence options."
+
+	EventsConfigDoc.AddExample("", machineEventsExample)
+	EventsConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "events",
+		},
+	}
+	EventsConfigDoc.Fields = make([]encoder.Doc, 3)
+	EventsConfigDoc.Fields[0].Name = "persist"
+	EventsConfigDoc.Fields[0].Type = "bool"
+	EventsConfigDoc.Fields[0].Note = ""
+	EventsConfigDoc.Fields[0].Description = "Persist events to the disk, so that events of the previous boots can be queried."
+	EventsConfigDoc.Fields[0].Comments[encoder.LineComment] = "Persist events to the disk, so that events of the previous boots can be queried."
+	EventsConfigDoc.Fields[1].Name = "partition"
+	EventsConfigDoc.Fields[1].Type = "string"
+	EventsConfigDoc.Fields[1].Note = ""
+	EventsConfigDoc.Fields[1].Description = "The partition to store the event log on.\nDefaults to `STATE`."
+	EventsConfigDoc.Fields[1].Comments[encoder.LineComment] = "The partition to store the event log on."
+	EventsConfigDoc.Fields[1].Values = []string{
+		"STATE",
+		"EPHEMERAL",
+	}
+	EventsConfigDoc.Fields[2].Name = "maxSize"
+	EventsConfigDoc.Fields[2].Type = "int"
+	EventsConfigDoc.Fields[2].Note = ""
+	EventsConfigDoc.Fields[2].Description = "Maximum size of the event log in bytes, older events are discarded when the log grows over the limit.\nDefaults to 1 MiB."
+	EventsConfigDoc.Fields[2].Comments[encoder.LineComment] = "Maximum size of the event log in bytes, older events are discarded when the log grows over the limit."
+
+	LoggingConfigDoc.Type = "LoggingConfig"
+	LoggingConfigDoc.Comments[encoder.LineComment] = "LoggingConfig represents the service log rotation and retention options."
+	LoggingConfigDoc.Description = "LoggingConfig represents the service log rotation and retention options."
+
+	LoggingConfigDoc.AddExample("", machineLoggingExample)
+	LoggingConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "logging",
+		},
+	}
+	LoggingConfigDoc.Fields = make([]encoder.Doc, 3)
+	LoggingConfigDoc.Fields[0].Name = "maxFileSize"
+	LoggingConfigDoc.Fields[0].Type = "int"
+	LoggingConfigDoc.Fields[0].Note = ""
+	LoggingConfigDoc.Fields[0].Description = "Maximum size of the service log file in bytes, the log is rotated when it grows over the limit.\nDefaults to 10 MiB."
+	LoggingConfigDoc.Fields[0].Comments[encoder.LineComment] = "Maximum size of the service log file in bytes, the log is rotated when it grows over the limit."
+	LoggingConfigDoc.Fields[1].Name = "maxAge"
+	LoggingConfigDoc.Fields[1].Type = "Duration"
+	LoggingConfigDoc.Fields[1].Note = ""
+	LoggingConfigDoc.Fields[1].Description = "Maximum age of the service log file, the log is rotated when it gets older than the limit.\nRotated logs older than the limit are removed.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 168h (7 days)."
+	LoggingConfigDoc.Fields[1].Comments[encoder.LineComment] = "Maximum age of the service log file, the log is rotated when it gets older than the limit."
+	LoggingConfigDoc.Fields[2].Name = "maxTotalSize"
+	LoggingConfigDoc.Fields[2].Type = "int"
+	LoggingConfigDoc.Fields[2].Note = ""
+	LoggingConfigDoc.Fields[2].Description = "Total size of the service logs on the node in bytes, the oldest rotated logs are removed\nwhen the logs grow over the limit.\nDefaults to 100 MiB."
+	LoggingConfigDoc.Fields[2].Comments[encoder.LineComment] = "Total size of the service logs on the node in bytes, the oldest rotated logs are removed"
+
+	HealthCheckConfigDoc.Type = "HealthCheckConfig"
+	HealthCheckConfigDoc.Comments[encoder.LineComment] = "HealthCheckConfig represents the custom health check."
+	HealthCheckConfigDoc.Description = "HealthCheckConfig represents the custom health check."
+
+	HealthCheckConfigDoc.AddExample("", machineHealthChecksExample)
+	HealthCheckConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "healthChecks",
+		},
+	}
+	HealthCheckConfigDoc.Fields = make([]encoder.Doc, 7)
+	HealthCheckConfigDoc.Fields[0].Name = "name"
+	HealthCheckConfigDoc.Fields[0].Type = "string"
+	HealthCheckConfigDoc.Fields[0].Note = ""
+	HealthCheckConfigDoc.Fields[0].Description = "The name of the health check."
+	HealthCheckConfigDoc.Fields[0].Comments[encoder.LineComment] = "The name of the health check."
+	HealthCheckConfigDoc.Fields[1].Name = "http"
+	HealthCheckConfigDoc.Fields[1].Type = "HTTPHealthCheckConfig"
+	HealthCheckConfigDoc.Fields[1].Note = ""
+	HealthCheckConfigDoc.Fields[1].Description = "HTTP health check: the check is successful if the HTTP GET request returns 2xx or 3xx status code."
+	HealthCheckConfigDoc.Fields[1].Comments[encoder.LineComment] = "HTTP health check: the check is successful if the HTTP GET request returns 2xx or 3xx status code."
+	HealthCheckConfigDoc.Fields[2].Name = "tcp"
+	HealthCheckConfigDoc.Fields[2].Type = "TCPHealthCheckConfig"
+	HealthCheckConfigDoc.Fields[2].Note = ""
+	HealthCheckConfigDoc.Fields[2].Description = "TCP health check: the check is successful if the TCP connection can be established."
+	HealthCheckConfigDoc.Fields[2].Comments[encoder.LineComment] = "TCP health check: the check is successful if the TCP connection can be established."
+	HealthCheckConfigDoc.Fields[3].Name = "exec"
+	HealthCheckConfigDoc.Fields[3].Type = "ExecHealthCheckConfig"
+	HealthCheckConfigDoc.Fields[3].Note = ""
+	HealthCheckConfigDoc.Fields[3].Description = "Exec health check: the check is successful if the command run in the container exits with zero exit code."
+	HealthCheckConfigDoc.Fields[3].Comments[encoder.LineComment] = "Exec health check: the check is successful if the command run in the container exits with zero exit code."
+	HealthCheckConfigDoc.Fields[4].Name = "interval"
+	HealthCheckConfigDoc.Fields[4].Type = "Duration"
+	HealthCheckConfigDoc.Fields[4].Note = ""
+	HealthCheckConfigDoc.Fields[4].Description = "The interval between the checks.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 5s."
+	HealthCheckConfigDoc.Fields[4].Comments[encoder.LineComment] = "The interval between the checks."
+	HealthCheckConfigDoc.Fields[5].Name = "timeout"
+	HealthCheckConfigDoc.Fields[5].Type = "Duration"
+	HealthCheckConfigDoc.Fields[5].Note = ""
+	HealthCheckConfigDoc.Fields[5].Description = "The timeout of a single check.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 1s."
+	HealthCheckConfigDoc.Fields[5].Comments[encoder.LineComment] = "The timeout of a single check."
+	HealthCheckConfigDoc.Fields[6].Name = "failureThreshold"
+	HealthCheckConfigDoc.Fields[6].Type = "int"
+	HealthCheckConfigDoc.Fields[6].Note = ""
+	HealthCheckConfigDoc.Fields[6].Description = "The number of consecutive failures before the check is reported unhealthy.\nDefaults to 1."
+	HealthCheckConfigDoc.Fields[6].Comments[encoder.LineComment] = "The number of consecutive failures before the check is reported unhealthy."
+
+	HTTPHealthCheckConfigDoc.Type = "HTTPHealthCheckConfig"
+	HTTPHealthCheckConfigDoc.Comments[encoder.LineComment] = "HTTPHealthCheckConfig represents the HTTP health check."
+	HTTPHealthCheckConfigDoc.Description = "HTTPHealthCheckConfig represents the HTTP health check."
+	HTTPHealthCheckConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "HealthCheckConfig",
+			FieldName: "http",
+		},
+	}
+	HTTPHealthCheckConfigDoc.Fields = make([]encoder.Doc, 1)
+	HTTPHealthCheckConfigDoc.Fields[0].Name = "url"
+	HTTPHealthCheckConfigDoc.Fields[0].Type = "string"
+	HTTPHealthCheckConfigDoc.Fields[0].Note = ""
+	HTTPHealthCheckConfigDoc.Fields[0].Description = "The URL to send the request to."
+	HTTPHealthCheckConfigDoc.Fields[0].Comments[encoder.LineComment] = "The URL to send the request to."
+
+	HTTPHealthCheckConfigDoc.Fields[0].AddExample("", "http://127.0.0.1:9500/healthz")
+
+	TCPHealthCheckConfigDoc.Type = "TCPHealthCheckConfig"
+	TCPHealthCheckConfigDoc.Comments[encoder.LineComment] = "TCPHealthCheckConfig represents the TCP health check."
+	TCPHealthCheckConfigDoc.Description = "TCPHealthCheckConfig represents the TCP health check."
+	TCPHealthCheckConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "HealthCheckConfig",
+			FieldName: "tcp",
+		},
+	}
+	TCPHealthCheckConfigDoc.Fields = make([]encoder.Doc, 1)
+	TCPHealthCheckConfigDoc.Fields[0].Name = "address"
+	TCPHealthCheckConfigDoc.Fields[0].Type = "string"
+	TCPHealthCheckConfigDoc.Fields[0].Note = ""
+	TCPHealthCheckConfigDoc.Fields[0].Description = "The address (`host:port`) to connect to."
+	TCPHealthCheckConfigDoc.Fields[0].Comments[encoder.LineComment] = "The address (`host:port`) to connect to."
+
+	TCPHealthCheckConfigDoc.Fields[0].AddExample("", "127.0.0.1:3260")
+
+	ExecHealthCheckConfigDoc.Type = "ExecHealthCheckConfig"
+	ExecHealthCheckConfigDoc.Comments[encoder.LineComment] = "ExecHealthCheckConfig represents the health check running the command in the container."
+	ExecHealthCheckConfigDoc.Description = "ExecHealthCheckConfig represents the health check running the command in the container."
+	ExecHealthCheckConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "HealthCheckConfig",
+			FieldName: "exec",
+		},
+	}
+	ExecHealthCheckConfigDoc.Fields = make([]encoder.Doc, 3)
+	ExecHealthCheckConfigDoc.Fields[0].Name = "namespace"
+	ExecHealthCheckConfigDoc.Fields[0].Type = "string"
+	ExecHealthCheckConfigDoc.Fields[0].Note = ""
+	ExecHealthCheckConfigDoc.Fields[0].Description = "The containerd namespace of the container.\nDefaults to `k8s.io`."
+	ExecHealthCheckConfigDoc.Fields[0].Comments[encoder.LineComment] = "The containerd namespace of the container."
+	ExecHealthCheckConfigDoc.Fields[0].Values = []string{
+		"system",
+		"k8s.io",
+	}
+	ExecHealthCheckConfigDoc.Fields[1].Name = "container"
+	ExecHealthCheckConfigDoc.Fields[1].Type = "string"
+	ExecHealthCheckConfigDoc.Fields[1].Note = ""
+	ExecHealthCheckConfigDoc.Fields[1].Description = "The container ID, for Kubernetes containers `namespace/pod:container` format is accepted."
+	ExecHealthCheckConfigDoc.Fields[1].Comments[encoder.LineComment] = "The container ID, for Kubernetes containers `namespace/pod:container` format is accepted."
+	ExecHealthCheckConfigDoc.Fields[2].Name = "command"
+	ExecHealthCheckConfigDoc.Fields[2].Type = "[]string"
+	ExecHealthCheckConfigDoc.Fields[2].Note = ""
+	ExecHealthCheckConfigDoc.Fields[2].Description = "The command to run in the container."
+	ExecHealthCheckConfigDoc.Fields[2].Comments[encoder.LineComment] = "The command to run in the container."
+
 	RegistriesConfigDoc.Type = "RegistriesConfig"
 	RegistriesConfigDoc.Comments[encoder.LineComment] = "RegistriesConfig represents the image pull options."
 	RegistriesConfigDoc.Description = "RegistriesConfig represents the image pull options."
@@ -853,6 +1153,25 @@ func init() {
 	AdminKubeconfigConfigDoc.Fields[0].Description = "Admin kubeconfig certificate lifetime (default is 1 year).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
 	AdminKubeconfigConfigDoc.Fields[0].Comments[encoder.LineComment] = "Admin kubeconfig certificate lifetime (default is 1 year)."
 
+	ClusterInlineManifestDoc.Type = "ClusterInlineManifest"
+	ClusterInlineManifestDoc.Comments[encoder.LineComment] = "ClusterInlineManifest struct describes inline bootstrap manifests for the user."
+	ClusterInlineManifestDoc.Description = "ClusterInlineManifest struct describes inline bootstrap manifests for the user."
+	ClusterInlineManifestDoc.Fields = make([]encoder.Doc, 2)
+	ClusterInlineManifestDoc.Fields[0].Name = "name"
+	ClusterInlineManifestDoc.Fields[0].Type = "string"
+	ClusterInlineManifestDoc.Fields[0].Note = ""
+	ClusterInlineManifestDoc.Fields[0].Description = "Name of the manifest.\nName should be unique."
+	ClusterInlineManifestDoc.Fields[0].Comments[encoder.LineComment] = "Name of the manifest."
+
+	ClusterInlineManifestDoc.Fields[0].AddExample("", "csi")
+	ClusterInlineManifestDoc.Fields[1].Name = "contents"
+	ClusterInlineManifestDoc.Fields[1].Type = "string"
+	ClusterInlineManifestDoc.Fields[1].Note = ""
+	ClusterInlineManifestDoc.Fields[1].Description = "Manifest contents as a string."
+	ClusterInlineManifestDoc.Fields[1].Comments[encoder.LineComment] = "Manifest contents as a string."
+
+	ClusterInlineManifestDoc.Fields[1].AddExample("", "/* --- */")
+
 	MachineDiskDoc.Type = "MachineDisk"
 	MachineDiskDoc.Comments[encoder.LineComment] = "MachineDisk represents the options available for partitioning, formatting, and"
 	MachineDiskDoc.Description = "MachineDisk represents the options available for partitioning, formatting, and\nmounting extra disks.\n"
@@ -1352,12 +1671,22 @@ func init() {
 			FieldName: "mirrors",
 		},
 	}
-	RegistryMirrorConfigDoc.Fields = make([]encoder.Doc, 1)
+	RegistryMirrorConfigDoc.Fields = make([]encoder.Doc, 3)
 	RegistryMirrorConfigDoc.Fields[0].Name = "endpoints"
 	RegistryMirrorConfigDoc.Fields[0].Type = "[]string"
 	RegistryMirrorConfigDoc.Fields[0].Note = ""
-	RegistryMirrorConfigDoc.Fields[0].Description = "List of endpoints (URLs) for registry mirrors to use.\nEndpoint configures HTTP/HTTPS access mode, host name,\nport and path (if path is not set, it defaults to `/v2`)."
+	RegistryMirrorConfigDoc.Fields[0].Description = "List of endpoints (URLs) for registry mirrors to use.\nEndpoint configures HTTP/HTTPS access mode, host name,\nport and path (if path is not set, it defaults to `/v2`).\n\nEndpoints are tried in order, the registry itself is used as the last\nendpoint unless `skipFallback` is set.\nEndpoints which fail consistently are skipped for a while.\n\nBefore Talos 0.9 the registry itself was never used if the endpoints are set,\nset `skipFallback` to keep that behavior."
 	RegistryMirrorConfigDoc.Fields[0].Comments[encoder.LineComment] = "List of endpoints (URLs) for registry mirrors to use."
+	RegistryMirrorConfigDoc.Fields[1].Name = "appendV2Path"
+	RegistryMirrorConfigDoc.Fields[1].Type = "bool"
+	RegistryMirrorConfigDoc.Fields[1].Note = ""
+	RegistryMirrorConfigDoc.Fields[1].Description = "Append `/v2` to the endpoint path unless the path already ends with `/v2`.\n\nBy default endpoint path is used as is."
+	RegistryMirrorConfigDoc.Fields[1].Comments[encoder.LineComment] = "Append `/v2` to the endpoint path unless the path already ends with `/v2`."
+	RegistryMirrorConfigDoc.Fields[2].Name = "skipFallback"
+	RegistryMirrorConfigDoc.Fields[2].Type = "bool"
+	RegistryMirrorConfigDoc.Fields[2].Note = ""
+	RegistryMirrorConfigDoc.Fields[2].Description = "Don't fall back to the upstream registry if all the endpoints fail.\n\nOnly the images pulled by Talos follow this setting: CRI (Kubernetes images)\nalways falls back to the upstream registry and doesn't skip the failing endpoints."
+	RegistryMirrorConfigDoc.Fields[2].Comments[encoder.LineComment] = "Don't fall back to the upstream registry if all the endpoints fail."
 
 	RegistryConfigDoc.Type = "RegistryConfig"
 	RegistryConfigDoc.Comments[encoder.LineComment] = "RegistryConfig specifies auth & TLS config per registry."
@@ -1478,10 +1807,42 @@ func (_ InstallConfig) Doc() *encoder.Doc {
 	return &InstallConfigDoc
 }
 
+func (_ InstallImageCacheConfig) Doc() *encoder.Doc {
+	return &InstallImageCacheConfigDoc
+}
+
 func (_ TimeConfig) Doc() *encoder.Doc {
 	return &TimeConfigDoc
 }
 
+func (_ MetricsConfig) Doc() *encoder.Doc {
+	return &MetricsConfigDoc
+}
+
+func (_ EventsConfig) Doc() *encoder.Doc {
+	return &EventsConfigDoc
+}
+
+func (_ LoggingConfig) Doc() *encoder.Doc {
+	return &LoggingConfigDoc
+}
+
+func (_ HealthCheckConfig) Doc() *encoder.Doc {
+	return &HealthCheckConfigDoc
+}
+
+func (_ HTTPHealthCheckConfig) Doc() *encoder.Doc {
+	return &HTTPHealthCheckConfigDoc
+}
+
+func (_ TCPHealthCheckConfig) Doc() *encoder.Doc {
+	return &TCPHealthCheckConfigDoc
+}
+
+func (_ ExecHealthCheckConfig) Doc() *encoder.Doc {
+	return &ExecHealthCheckConfigDoc
+}
+
 func (_ RegistriesConfig) Doc() *encoder.Doc {
 	return &RegistriesConfigDoc
 }
@@ -1534,6 +1895,10 @@ func (_ AdminKubeconfigConfig) Doc() *encoder.Doc {
 	return &AdminKubeconfigConfigDoc
 }
 
+func (_ ClusterInlineManifest) Doc() *encoder.Doc {
+	return &ClusterInlineManifestDoc
+}
+
 func (_ MachineDisk) Doc() *encoder.Doc {
 	return &MachineDiskDoc
 }
@@ -1606,7 +1971,15 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&KubeletConfigDoc,
 			&NetworkConfigDoc,
 			&InstallConfigDoc,
+			&InstallImageCacheConfigDoc,
 			&TimeConfigDoc,
+			&MetricsConfigDoc,
+			&EventsConfigDoc,
+			&LoggingConfigDoc,
+			&HealthCheckConfigDoc,
+			&HTTPHealthCheckConfigDoc,
+			&TCPHealthCheckConfigDoc,
+			&ExecHealthCheckConfigDoc,
 			&RegistriesConfigDoc,
 			&PodCheckpointerDoc,
 			&CoreDNSDoc,
@@ -1620,6 +1993,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ClusterNetworkConfigDoc,
 			&CNIConfigDoc,
 			&AdminKubeconfigConfigDoc,
+			&ClusterInlineManifestDoc,
 			&MachineDiskDoc,
 			&DiskPartitionDoc,
 			&MachineFileDoc,
@@ -7,15 +7,21 @@
 package v1alpha1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 
 	valid "github.com/asaskevich/govalidator"
 	"github.com/hashicorp/go-multierror"
 	talosnet "github.com/talos-systems/net"
+	yaml "gopkg.in/yaml.v3"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -115,6 +121,29 @@ func (c *Config) Validate(mode config.RuntimeMode) error {
 		}
 	}
 
+	if c.MachineConfig.MachineMetrics != nil && c.MachineConfig.MachineMetrics.MetricsBindAddress != "" {
+		if _, _, err := net.SplitHostPort(c.MachineConfig.MachineMetrics.MetricsBindAddress); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid metrics bind address %q: %w", c.MachineConfig.MachineMetrics.MetricsBindAddress, err))
+		}
+	}
+
+	if c.MachineConfig.MachineEvents != nil {
+		switch c.MachineConfig.MachineEvents.EventsPartition {
+		case "", constants.StatePartitionLabel, constants.EphemeralPartitionLabel:
+		default:
+			result = multierror.Append(result, fmt.Errorf("invalid events partition %q: should be one of %q, %q",
+				c.MachineConfig.MachineEvents.EventsPartition, constants.StatePartitionLabel, constants.EphemeralPartitionLabel))
+		}
+
+		if c.MachineConfig.MachineEvents.EventsMaxSize < 0 {
+			result = multierror.Append(result, fmt.Errorf("events max size should be non-negative: %d", c.MachineConfig.MachineEvents.EventsMaxSize))
+		}
+	}
+
+	if err := validateHealthChecks(c.MachineConfig.MachineHealthChecks); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	if !valid.IsDNSName(c.ClusterConfig.ClusterNetwork.DNSDomain) {
 		result = multierror.Append(result, fmt.Errorf("%q is not a valid DNS name", c.ClusterConfig.ClusterNetwork.DNSDomain))
 	}
@@ -138,6 +167,144 @@ func (c *ClusterConfig) Validate() error {
 		result = multierror.Append(result, fmt.Errorf("invalid controlplane endpoint: %w", err))
 	}
 
+	manifestURLs := map[string]struct{}{}
+
+	for _, url := range c.ExtraManifests {
+		manifestURLs[url] = struct{}{}
+	}
+
+	if c.ClusterNetwork != nil && c.ClusterNetwork.CNI != nil {
+		for _, url := range c.ClusterNetwork.CNI.CNIUrls {
+			manifestURLs[url] = struct{}{}
+		}
+	}
+
+	for url, digest := range c.ExtraManifestDigests {
+		if _, ok := manifestURLs[url]; !ok {
+			result = multierror.Append(result, fmt.Errorf("digest is specified for unknown manifest %q", url))
+		}
+
+		if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != sha256.Size {
+			result = multierror.Append(result, fmt.Errorf("digest for manifest %q should be hex-encoded SHA-256", url))
+		}
+	}
+
+	manifestNames := map[string]struct{}{}
+
+	for _, manifest := range c.ClusterInlineManifests {
+		if strings.TrimSpace(manifest.InlineManifestName) == "" {
+			result = multierror.Append(result, fmt.Errorf("inline manifest name can't be empty"))
+
+			continue
+		}
+
+		if _, ok := manifestNames[manifest.InlineManifestName]; ok {
+			result = multierror.Append(result, fmt.Errorf("inline manifest name %q is duplicate", manifest.InlineManifestName))
+		}
+
+		manifestNames[manifest.InlineManifestName] = struct{}{}
+
+		if err := validateInlineManifest(manifest.InlineManifestContents); err != nil {
+			result = multierror.Append(result, fmt.Errorf("inline manifest %q is invalid: %w", manifest.InlineManifestName, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateInlineManifest checks that every YAML document of the manifest is a Kubernetes object.
+func validateInlineManifest(contents string) error {
+	decoder := yaml.NewDecoder(strings.NewReader(contents))
+
+	for i := 0; ; i++ {
+		var obj map[string]interface{}
+
+		if err := decoder.Decode(&obj); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return fmt.Errorf("error decoding document %d: %w", i, err)
+		}
+
+		// empty document
+		if obj == nil {
+			continue
+		}
+
+		for _, key := range []string{"apiVersion", "kind"} {
+			if value, ok := obj[key].(string); !ok || value == "" {
+				return fmt.Errorf("document %d is missing %q", i, key)
+			}
+		}
+	}
+}
+
+// validateHealthChecks checks that custom health checks have unique names and exactly one check defined.
+//
+//nolint: gocyclo
+func validateHealthChecks(checks []*HealthCheckConfig) error {
+	var result *multierror.Error
+
+	names := map[string]struct{}{}
+
+	for _, check := range checks {
+		if !valid.IsDNSName(check.HealthCheckName) || strings.Contains(check.HealthCheckName, ".") {
+			result = multierror.Append(result, fmt.Errorf("health check name %q should be a valid DNS label", check.HealthCheckName))
+		}
+
+		if _, exists := names[check.HealthCheckName]; exists {
+			result = multierror.Append(result, fmt.Errorf("duplicate health check name %q", check.HealthCheckName))
+		}
+
+		names[check.HealthCheckName] = struct{}{}
+
+		defined := 0
+
+		if check.HealthCheckHTTP != nil {
+			defined++
+
+			if u, err := url.Parse(check.HealthCheckHTTP.HTTPURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				result = multierror.Append(result, fmt.Errorf("health check %q: invalid HTTP URL %q", check.HealthCheckName, check.HealthCheckHTTP.HTTPURL))
+			}
+		}
+
+		if check.HealthCheckTCP != nil {
+			defined++
+
+			if _, _, err := net.SplitHostPort(check.HealthCheckTCP.TCPAddress); err != nil {
+				result = multierror.Append(result, fmt.Errorf("health check %q: invalid TCP address %q: %w", check.HealthCheckName, check.HealthCheckTCP.TCPAddress, err))
+			}
+		}
+
+		if check.HealthCheckExec != nil {
+			defined++
+
+			switch check.HealthCheckExec.ExecNamespace {
+			case "", constants.SystemContainerdNamespace, constants.K8sContainerdNamespace:
+			default:
+				result = multierror.Append(result, fmt.Errorf("health check %q: invalid namespace %q: should be one of %q, %q",
+					check.HealthCheckName, check.HealthCheckExec.ExecNamespace, constants.SystemContainerdNamespace, constants.K8sContainerdNamespace))
+			}
+
+			if check.HealthCheckExec.ExecContainer == "" {
+				result = multierror.Append(result, fmt.Errorf("health check %q: container is required", check.HealthCheckName))
+			}
+
+			if len(check.HealthCheckExec.ExecCommand) == 0 {
+				result = multierror.Append(result, fmt.Errorf("health check %q: command is required", check.HealthCheckName))
+			}
+		}
+
+		if defined != 1 {
+			result = multierror.Append(result, fmt.Errorf("health check %q: exactly one of http, tcp or exec should be specified", check.HealthCheckName))
+		}
+
+		if check.HealthCheckInterval < 0 || check.HealthCheckTimeout < 0 || check.HealthCheckFailureThreshold < 0 {
+			result = multierror.Append(result, fmt.Errorf("health check %q: interval, timeout and failure threshold should be non-negative", check.HealthCheckName))
+		}
+	}
+
 	return result.ErrorOrNil()
 }
 
@@ -0,0 +1,284 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestClusterConfigValidateInlineManifests(t *testing.T) {
+	endpoint, err := url.Parse("https://10.5.0.1:6443")
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name            string
+		inlineManifests v1alpha1.ClusterInlineManifests
+		expectedError   string
+	}{
+		{
+			name: "valid",
+			inlineManifests: v1alpha1.ClusterInlineManifests{
+				{
+					InlineManifestName:     "namespace",
+					InlineManifestContents: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ci\n---\n---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: test\n",
+				},
+			},
+		},
+		{
+			name: "empty name",
+			inlineManifests: v1alpha1.ClusterInlineManifests{
+				{
+					InlineManifestContents: "apiVersion: v1\nkind: Namespace\n",
+				},
+			},
+			expectedError: "1 error occurred:\n\t* inline manifest name can't be empty\n\n",
+		},
+		{
+			name: "duplicate name",
+			inlineManifests: v1alpha1.ClusterInlineManifests{
+				{
+					InlineManifestName:     "namespace",
+					InlineManifestContents: "apiVersion: v1\nkind: Namespace\n",
+				},
+				{
+					InlineManifestName:     "namespace",
+					InlineManifestContents: "apiVersion: v1\nkind: Namespace\n",
+				},
+			},
+			expectedError: "1 error occurred:\n\t* inline manifest name \"namespace\" is duplicate\n\n",
+		},
+		{
+			name: "missing kind",
+			inlineManifests: v1alpha1.ClusterInlineManifests{
+				{
+					InlineManifestName:     "namespace",
+					InlineManifestContents: "apiVersion: v1\nkind: Namespace\n---\napiVersion: v1\n",
+				},
+			},
+			expectedError: "1 error occurred:\n\t* inline manifest \"namespace\" is invalid: document 1 is missing \"kind\"\n\n",
+		},
+		{
+			name: "invalid yaml",
+			inlineManifests: v1alpha1.ClusterInlineManifests{
+				{
+					InlineManifestName:     "namespace",
+					InlineManifestContents: "apiVersion: [v1\n",
+				},
+			},
+			expectedError: "1 error occurred:\n\t* inline manifest \"namespace\" is invalid: error decoding document 0: yaml: line 1: did not find expected ',' or ']'\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: endpoint,
+					},
+				},
+				ClusterInlineManifests: test.inlineManifests,
+			}
+
+			err := cfg.Validate()
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestClusterConfigValidateExtraManifestDigests(t *testing.T) {
+	endpoint, err := url.Parse("https://10.5.0.1:6443")
+	require.NoError(t, err)
+
+	const digest = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	for _, test := range []struct {
+		name          string
+		digests       map[string]string
+		expectedError string
+	}{
+		{
+			name: "valid",
+			digests: map[string]string{
+				"https://example.com/manifest.yaml": digest,
+				"https://example.com/cni.yaml":      digest,
+			},
+		},
+		{
+			name: "unknown manifest",
+			digests: map[string]string{
+				"https://example.com/other.yaml": digest,
+			},
+			expectedError: "1 error occurred:\n\t* digest is specified for unknown manifest \"https://example.com/other.yaml\"\n\n",
+		},
+		{
+			name: "invalid digest",
+			digests: map[string]string{
+				"https://example.com/manifest.yaml": "abcd",
+			},
+			expectedError: "1 error occurred:\n\t* digest for manifest \"https://example.com/manifest.yaml\" should be hex-encoded SHA-256\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: endpoint,
+					},
+				},
+				ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+					CNI: &v1alpha1.CNIConfig{
+						CNIName: "custom",
+						CNIUrls: []string{"https://example.com/cni.yaml"},
+					},
+				},
+				ExtraManifests:       []string{"https://example.com/manifest.yaml"},
+				ExtraManifestDigests: test.digests,
+			}
+
+			err := cfg.Validate()
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+type runtimeMode struct{}
+
+func (runtimeMode) String() string {
+	return "container"
+}
+
+func (runtimeMode) RequiresInstall() bool {
+	return false
+}
+
+func TestConfigValidateHealthChecks(t *testing.T) {
+	endpoint, err := url.Parse("https://10.5.0.1:6443")
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name          string
+		checks        []*v1alpha1.HealthCheckConfig
+		expectedError string
+	}{
+		{
+			name: "valid",
+			checks: []*v1alpha1.HealthCheckConfig{
+				{
+					HealthCheckName: "agent",
+					HealthCheckHTTP: &v1alpha1.HTTPHealthCheckConfig{
+						HTTPURL: "http://127.0.0.1:9500/healthz",
+					},
+				},
+				{
+					HealthCheckName: "iscsi",
+					HealthCheckTCP: &v1alpha1.TCPHealthCheckConfig{
+						TCPAddress: "127.0.0.1:3260",
+					},
+				},
+				{
+					HealthCheckName: "csi",
+					HealthCheckExec: &v1alpha1.ExecHealthCheckConfig{
+						ExecContainer: "storage/csi-node:plugin",
+						ExecCommand:   []string{"/bin/probe"},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate name",
+			checks: []*v1alpha1.HealthCheckConfig{
+				{
+					HealthCheckName: "agent",
+					HealthCheckTCP: &v1alpha1.TCPHealthCheckConfig{
+						TCPAddress: "127.0.0.1:9500",
+					},
+				},
+				{
+					HealthCheckName: "agent",
+					HealthCheckTCP: &v1alpha1.TCPHealthCheckConfig{
+						TCPAddress: "127.0.0.1:9501",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* duplicate health check name \"agent\"\n\n",
+		},
+		{
+			name: "no check",
+			checks: []*v1alpha1.HealthCheckConfig{
+				{
+					HealthCheckName: "agent",
+				},
+			},
+			expectedError: "1 error occurred:\n\t* health check \"agent\": exactly one of http, tcp or exec should be specified\n\n",
+		},
+		{
+			name: "invalid",
+			checks: []*v1alpha1.HealthCheckConfig{
+				{
+					HealthCheckName: "agent",
+					HealthCheckHTTP: &v1alpha1.HTTPHealthCheckConfig{
+						HTTPURL: "127.0.0.1:9500",
+					},
+				},
+				{
+					HealthCheckName: "csi",
+					HealthCheckExec: &v1alpha1.ExecHealthCheckConfig{
+						ExecNamespace: "default",
+						ExecContainer: "csi",
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* health check \"agent\": invalid HTTP URL \"127.0.0.1:9500\"\n\t* health check \"csi\": invalid namespace \"default\": should be one of \"system\", \"k8s.io\"\n\t* health check \"csi\": command is required\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &v1alpha1.Config{
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:         "join",
+					MachineHealthChecks: test.checks,
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: endpoint,
+						},
+					},
+					ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+						DNSDomain: "cluster.local",
+					},
+				},
+			}
+
+			err := cfg.Validate(runtimeMode{})
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
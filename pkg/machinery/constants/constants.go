@@ -112,6 +112,18 @@ const (
 	// the data path.
 	EphemeralMountPoint = "/var"
 
+	// ImageCachePartitionLabel is the label of the partition holding the container image cache.
+	ImageCachePartitionLabel = "IMAGECACHE"
+
+	// ImageCacheMountPoint is the path the image cache partition is mounted at.
+	ImageCacheMountPoint = "/system/imagecache"
+
+	// ImageCacheImportedMarker is created once the cached images are imported into CRI containerd.
+	ImageCacheImportedMarker = "/system/imagecache-imported"
+
+	// ImageCacheSourcePath is the path in the installer container the image cache archives are copied from.
+	ImageCacheSourcePath = "/usr/install/image-cache"
+
 	// RootMountPoint is the label of the partition to use for mounting at
 	// the root path.
 	RootMountPoint = "/"
@@ -159,6 +171,15 @@ const (
 	// KubernetesAdminCertDefaultLifetime defines default lifetime for Kubernetes generated admin certificate.
 	KubernetesAdminCertDefaultLifetime = 365 * 24 * time.Hour
 
+	// TalosAdminCertDefaultLifetime defines default lifetime for Talos API client certificate issued by the node.
+	TalosAdminCertDefaultLifetime = 30 * 24 * time.Hour
+
+	// TalosAdminCertMaxLifetime defines maximum lifetime for Talos API client certificate issued by the node.
+	TalosAdminCertMaxLifetime = 30 * 24 * time.Hour
+
+	// TalosAdminCertExpiryWarning defines how long before Talos API client certificate expiration talosctl starts warning.
+	TalosAdminCertExpiryWarning = 7 * 24 * time.Hour
+
 	// KubebernetesStaticSecretsDir defines ephemeral directory which contains rendered secrets for controlplane components.
 	KubebernetesStaticSecretsDir = "/system/secrets/kubernetes"
 
@@ -220,6 +241,20 @@ const (
 	// LabelNodeRoleControlPlane is the node label required by a control plane node.
 	LabelNodeRoleControlPlane = "node-role.kubernetes.io/control-plane"
 
+	// KubernetesFieldManager is the field manager used by Talos to apply Kubernetes objects.
+	KubernetesFieldManager = "talos"
+
+	// LabelManifestOwner is the label set on Kubernetes objects applied from Talos manifests.
+	//
+	// Objects with the label which are no longer part of any manifest are pruned.
+	LabelManifestOwner = "talos.dev/owner"
+
+	// LabelManifestOwnerValue is the value of the LabelManifestOwner label.
+	LabelManifestOwnerValue = "talos"
+
+	// AnnotationManifest is the annotation recording the Talos manifest the Kubernetes object was applied from.
+	AnnotationManifest = "talos.dev/manifest"
+
 	// ManifestsDirectory is the directory that contains all static manifests.
 	ManifestsDirectory = "/etc/kubernetes/manifests"
 
@@ -238,6 +273,9 @@ const (
 	// EtcdTalosManifestApplyMutex is the etcd election .
 	EtcdTalosManifestApplyMutex = EtcdRootTalosKey + ":manifestApplyMutex"
 
+	// EtcdTalosManifestApplyElection is the etcd election prefix for the control plane node which applies the manifests.
+	EtcdTalosManifestApplyElection = EtcdRootTalosKey + ":manifestApplyElection"
+
 	// EtcdImage is the reposistory for the etcd image.
 	EtcdImage = "gcr.io/etcd-development/etcd"
 
@@ -250,6 +288,24 @@ const (
 	// ConfigPath is the path to the downloaded config.
 	ConfigPath = StateMountPoint + "/config.yaml"
 
+	// EventLogDir is the directory relative to the partition mount point to store persisted events.
+	EventLogDir = "events"
+
+	// DefaultEventLogMaxSize is the default maximum size of the persisted event log.
+	DefaultEventLogMaxSize = 1024 * 1024
+
+	// DefaultLogMaxFileSize is the default size of the service log file which triggers the log rotation.
+	DefaultLogMaxFileSize = 10 * 1024 * 1024
+
+	// DefaultLogMaxAge is the default age of the service log file which triggers the log rotation.
+	DefaultLogMaxAge = 7 * 24 * time.Hour
+
+	// DefaultLogMaxTotalSize is the default total size of the service logs on the node.
+	DefaultLogMaxTotalSize = 100 * 1024 * 1024
+
+	// LogPath is the directory the service logs are persisted to.
+	LogPath = "/var/log"
+
 	// MetalConfigISOLabel is the volume label for ISO based configuration.
 	MetalConfigISOLabel = "metal-iso"
 
@@ -274,6 +330,12 @@ const (
 	// ApidPort is the port for the apid service.
 	ApidPort = 50000
 
+	// DefaultHealthCheckInterval is the default interval of the custom health checks.
+	DefaultHealthCheckInterval = 5 * time.Second
+
+	// DefaultHealthCheckTimeout is the default timeout of the custom health checks.
+	DefaultHealthCheckTimeout = time.Second
+
 	// TrustdPort is the port for the trustd service.
 	TrustdPort = 50001
 
@@ -283,6 +345,9 @@ const (
 	// SystemContainerdNamespace is the Containerd namespace for Talos services.
 	SystemContainerdNamespace = "system"
 
+	// K8sContainerdNamespace is the Containerd namespace for Kubernetes containers.
+	K8sContainerdNamespace = "k8s.io"
+
 	// SystemContainerdAddress is the path to the system containerd socket.
 	SystemContainerdAddress = SystemRunPath + "/containerd/containerd.sock"
 
@@ -381,6 +446,19 @@ const (
 	// NodeReadyTimeout is the timeout to wait for the node to be ready (CNI to be running).
 	// For bootstrap API, this includes time to run bootstrap.
 	NodeReadyTimeout = BootTimeout
+
+	// DefaultUpgradeHealthTimeout is the default timeout for the node to become healthy after an upgrade
+	// before it is reverted to the previous installation.
+	DefaultUpgradeHealthTimeout = 10 * time.Minute
+
+	// DefaultPacketCaptureSnapLen is the default snap length for the packet capture.
+	DefaultPacketCaptureSnapLen = 65536
+
+	// MaxPacketCaptureSnapLen is the maximum snap length for the packet capture.
+	MaxPacketCaptureSnapLen = 262144
+
+	// MaxPacketCaptureDuration is the maximum duration of the packet capture.
+	MaxPacketCaptureDuration = 30 * time.Minute
 )
 
 // See https://linux.die.net/man/3/klogctl